@@ -1,6 +1,16 @@
 package accounting
 
-import "github.com/nspcc-dev/neofs-api-go/v2/accounting"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
+	"github.com/nspcc-dev/neofs-api-go/v2/accounting"
+)
 
 // Decimal represents decimal number for accounting operations.
 //
@@ -62,3 +72,334 @@ func (d Decimal) Precision() uint32 {
 func (d *Decimal) SetPrecision(p uint32) {
 	(*accounting.Decimal)(d).SetPrecision(p)
 }
+
+// Errors returned by Decimal operations.
+var (
+	// ErrOverflow is returned when result of the operation doesn't fit into
+	// Decimal.
+	ErrOverflow = errors.New("decimal overflow")
+
+	// ErrPrecisionLoss is returned when operation can't be performed without
+	// loss of significant digits.
+	ErrPrecisionLoss = errors.New("decimal precision loss")
+)
+
+// maxInt64Digits is the number of decimal digits after which any non-zero
+// value multiplied by 10^n overflows int64.
+const maxInt64Digits = 19
+
+// fixed8Precision is a precision of fixedn.Fixed8 numbers.
+const fixed8Precision = 8
+
+func (d Decimal) bigValue() *big.Int {
+	return big.NewInt(d.Value())
+}
+
+// scaleUp multiplies v by 10^n. Returns false if result definitely overflows
+// int64, so it is not calculated.
+func scaleUp(v *big.Int, n uint32) (*big.Int, bool) {
+	if n == 0 || v.Sign() == 0 {
+		return v, true
+	}
+
+	if n > maxInt64Digits {
+		return nil, false
+	}
+
+	return v.Mul(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)), true
+}
+
+// newDecimal returns Decimal with the given value and precision. Returns
+// ErrOverflow if the value overflows int64.
+func newDecimal(v *big.Int, precision uint32) (Decimal, error) {
+	var res Decimal
+
+	if v == nil || !v.IsInt64() {
+		return res, ErrOverflow
+	}
+
+	res.SetValue(v.Int64())
+	res.SetPrecision(precision)
+
+	return res, nil
+}
+
+// alignPrecision returns values of x and y in the maximum precision of them.
+func alignPrecision(x, y Decimal) (*big.Int, *big.Int, uint32, error) {
+	xv, yv := x.bigValue(), y.bigValue()
+	xp, yp := x.Precision(), y.Precision()
+
+	var ok bool
+
+	if xp < yp {
+		xv, ok = scaleUp(xv, yp-xp)
+		xp = yp
+	} else {
+		yv, ok = scaleUp(yv, xp-yp)
+	}
+
+	if !ok {
+		return nil, nil, 0, ErrOverflow
+	}
+
+	return xv, yv, xp, nil
+}
+
+// Add returns sum of the Decimal and x in the maximum precision of them.
+// Returns ErrOverflow if the result doesn't fit into Decimal.
+//
+// See also Sub.
+func (d Decimal) Add(x Decimal) (Decimal, error) {
+	dv, xv, p, err := alignPrecision(d, x)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return newDecimal(dv.Add(dv, xv), p)
+}
+
+// Sub returns difference of the Decimal and x in the maximum precision of
+// them. Returns ErrOverflow if the result doesn't fit into Decimal.
+//
+// See also Add.
+func (d Decimal) Sub(x Decimal) (Decimal, error) {
+	dv, xv, p, err := alignPrecision(d, x)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return newDecimal(dv.Sub(dv, xv), p)
+}
+
+// Mul returns exact product of the Decimal and x. Precision of the result is
+// a sum of the operand precisions, use Rescale or Truncate to change it.
+// Returns ErrOverflow if the result doesn't fit into Decimal.
+//
+// See also MulInt.
+func (d Decimal) Mul(x Decimal) (Decimal, error) {
+	p := uint64(d.Precision()) + uint64(x.Precision())
+	if p > math.MaxUint32 {
+		return Decimal{}, ErrOverflow
+	}
+
+	v := d.bigValue()
+
+	return newDecimal(v.Mul(v, x.bigValue()), uint32(p))
+}
+
+// MulInt returns product of the Decimal and integer n in the precision of
+// the Decimal. Returns ErrOverflow if the result doesn't fit into Decimal.
+//
+// See also Mul.
+func (d Decimal) MulInt(n int64) (Decimal, error) {
+	v := d.bigValue()
+
+	return newDecimal(v.Mul(v, big.NewInt(n)), d.Precision())
+}
+
+// Cmp compares the Decimal with x regardless of their precisions and returns:
+//
+//	-1 if d < x
+//	 0 if d == x
+//	+1 if d > x
+func (d Decimal) Cmp(x Decimal) int {
+	dv, xv := d.bigValue(), x.bigValue()
+	dp, xp := d.Precision(), x.Precision()
+
+	// the value with the lower precision is scaled up; if it overflows, its
+	// magnitude exceeds any int64, so the sign decides
+	if dp < xp {
+		if scaled, ok := scaleUp(dv, xp-dp); ok {
+			return scaled.Cmp(xv)
+		}
+
+		return dv.Sign()
+	}
+
+	if scaled, ok := scaleUp(xv, dp-xp); ok {
+		return dv.Cmp(scaled)
+	}
+
+	return -xv.Sign()
+}
+
+// Rescale returns the Decimal in the given precision. Returns ErrOverflow if
+// the result doesn't fit into Decimal and ErrPrecisionLoss if the precision
+// decrease discards non-zero digits.
+//
+// See also Truncate.
+func (d Decimal) Rescale(p uint32) (Decimal, error) {
+	cur := d.Precision()
+	if p >= cur {
+		v, ok := scaleUp(d.bigValue(), p-cur)
+		if !ok {
+			return Decimal{}, ErrOverflow
+		}
+
+		return newDecimal(v, p)
+	}
+
+	res := d.Truncate(p)
+	if res.Cmp(d) != 0 {
+		return Decimal{}, ErrPrecisionLoss
+	}
+
+	return res, nil
+}
+
+// Truncate returns the Decimal in the given precision discarding extra
+// fractional digits (rounding toward zero). If the given precision is not
+// less than the current one, Truncate returns the Decimal as is.
+//
+// See also Rescale.
+func (d Decimal) Truncate(p uint32) Decimal {
+	cur := d.Precision()
+	if p >= cur {
+		return d
+	}
+
+	var res Decimal
+	res.SetPrecision(p)
+
+	if cur-p <= maxInt64Digits {
+		v := d.bigValue()
+		v.Quo(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(cur-p)), nil))
+		res.SetValue(v.Int64())
+	}
+
+	return res
+}
+
+// String returns exact decimal representation of the Decimal without
+// trailing fractional zeros, e.g. "12.5" or "-0.001".
+//
+// See also DecodeString, Format.
+func (d Decimal) String() string {
+	s := strconv.FormatUint(absUint64(d.Value()), 10)
+
+	p := int(d.Precision())
+
+	if p > 0 {
+		if len(s) <= p {
+			s = strings.Repeat("0", p-len(s)+1) + s
+		}
+
+		s = strings.TrimRight(s[:len(s)-p]+"."+s[len(s)-p:], "0")
+		s = strings.TrimSuffix(s, ".")
+	}
+
+	if d.Value() < 0 {
+		s = "-" + s
+	}
+
+	return s
+}
+
+// Format returns decimal representation of the Decimal (see String) followed
+// by the unit separated by space, e.g. "12.5 GAS". Unit is omitted if empty.
+//
+// See also DecodeStringWithUnit.
+func (d Decimal) Format(unit string) string {
+	if unit == "" {
+		return d.String()
+	}
+
+	return d.String() + " " + unit
+}
+
+func absUint64(v int64) uint64 {
+	if v < 0 {
+		return uint64(-(v + 1)) + 1
+	}
+
+	return uint64(v)
+}
+
+// DecodeString parses decimal number like "12.5", "-3" or "0.001" into
+// the Decimal. Precision of the Decimal is set to the number of the fractional
+// digits in s. Returns an error if s is not a decimal number or the number
+// doesn't fit into Decimal (ErrOverflow).
+//
+// See also String, DecodeStringWithUnit.
+func (d *Decimal) DecodeString(s string) error {
+	body := strings.TrimPrefix(s, "-")
+
+	intPart, fracPart := body, ""
+	if i := strings.IndexByte(body, '.'); i >= 0 {
+		intPart, fracPart = body[:i], body[i+1:]
+		if fracPart == "" {
+			return fmt.Errorf("invalid decimal '%s': missing fractional part", s)
+		}
+	}
+
+	if intPart == "" {
+		return fmt.Errorf("invalid decimal '%s': missing integer part", s)
+	}
+
+	digits := intPart + fracPart
+	for i := range digits {
+		if digits[i] < '0' || digits[i] > '9' {
+			return fmt.Errorf("invalid decimal '%s': unexpected character '%c'", s, digits[i])
+		}
+	}
+
+	v, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return fmt.Errorf("invalid decimal '%s'", s)
+	}
+
+	if len(body) != len(s) {
+		v.Neg(v)
+	}
+
+	if uint64(len(fracPart)) > math.MaxUint32 {
+		return fmt.Errorf("invalid decimal '%s': %w", s, ErrOverflow)
+	}
+
+	res, err := newDecimal(v, uint32(len(fracPart)))
+	if err != nil {
+		return fmt.Errorf("invalid decimal '%s': %w", s, err)
+	}
+
+	*d = res
+
+	return nil
+}
+
+// DecodeStringWithUnit parses decimal number followed by the given unit
+// separated by space, e.g. "12.5 GAS", into the Decimal. Returns an error if
+// the unit mismatches. See DecodeString for details.
+//
+// See also Format.
+func (d *Decimal) DecodeStringWithUnit(s, unit string) error {
+	num := strings.TrimSuffix(s, " "+unit)
+	if len(num) == len(s) {
+		return fmt.Errorf("invalid decimal '%s': missing unit '%s'", s, unit)
+	}
+
+	return d.DecodeString(num)
+}
+
+// SetFixed8 sets the Decimal to the value of fixedn.Fixed8 number with
+// precision 8.
+//
+// See also Fixed8.
+func (d *Decimal) SetFixed8(f fixedn.Fixed8) {
+	d.SetValue(int64(f))
+	d.SetPrecision(fixed8Precision)
+}
+
+// Fixed8 converts the Decimal into fixedn.Fixed8 number. Returns
+// ErrPrecisionLoss if the Decimal has non-zero digits beyond 8 fractional
+// ones (use Truncate to discard them), and ErrOverflow if the value doesn't
+// fit into fixedn.Fixed8.
+//
+// See also SetFixed8.
+func (d Decimal) Fixed8() (fixedn.Fixed8, error) {
+	res, err := d.Rescale(fixed8Precision)
+	if err != nil {
+		return 0, err
+	}
+
+	return fixedn.Fixed8(res.Value()), nil
+}
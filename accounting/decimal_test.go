@@ -1,8 +1,10 @@
 package accounting_test
 
 import (
+	"math"
 	"testing"
 
+	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
 	v2accounting "github.com/nspcc-dev/neofs-api-go/v2/accounting"
 	"github.com/nspcc-dev/neofs-sdk-go/accounting"
 	"github.com/stretchr/testify/require"
@@ -44,3 +46,177 @@ func TestDecimalMessageV2(t *testing.T) {
 	require.EqualValues(t, d.Value(), m2.GetValue())
 	require.EqualValues(t, d.Precision(), m2.GetPrecision())
 }
+
+func newDecimal(v int64, p uint32) (d accounting.Decimal) {
+	d.SetValue(v)
+	d.SetPrecision(p)
+
+	return
+}
+
+func TestDecimal_Add(t *testing.T) {
+	res, err := newDecimal(125, 1).Add(newDecimal(5, 3))
+	require.NoError(t, err)
+	require.Equal(t, newDecimal(12505, 3), res)
+
+	res, err = newDecimal(1, 0).Sub(newDecimal(25, 2))
+	require.NoError(t, err)
+	require.Equal(t, newDecimal(75, 2), res)
+
+	_, err = newDecimal(math.MaxInt64, 0).Add(newDecimal(1, 0))
+	require.ErrorIs(t, err, accounting.ErrOverflow)
+
+	_, err = newDecimal(math.MinInt64, 0).Sub(newDecimal(1, 0))
+	require.ErrorIs(t, err, accounting.ErrOverflow)
+
+	// rescaling overflows
+	_, err = newDecimal(1, 0).Add(newDecimal(1, 19))
+	require.ErrorIs(t, err, accounting.ErrOverflow)
+
+	_, err = newDecimal(1, 0).Add(newDecimal(1, 100))
+	require.ErrorIs(t, err, accounting.ErrOverflow)
+
+	// zero is rescaled to any precision
+	res, err = newDecimal(0, 0).Add(newDecimal(1, 100))
+	require.NoError(t, err)
+	require.Equal(t, newDecimal(1, 100), res)
+}
+
+func TestDecimal_Mul(t *testing.T) {
+	res, err := newDecimal(15, 1).Mul(newDecimal(-25, 2))
+	require.NoError(t, err)
+	require.Equal(t, newDecimal(-375, 3), res)
+
+	_, err = newDecimal(math.MaxInt64, 0).Mul(newDecimal(2, 0))
+	require.ErrorIs(t, err, accounting.ErrOverflow)
+
+	_, err = newDecimal(1, math.MaxUint32).Mul(newDecimal(1, 1))
+	require.ErrorIs(t, err, accounting.ErrOverflow)
+
+	res, err = newDecimal(15, 1).MulInt(3)
+	require.NoError(t, err)
+	require.Equal(t, newDecimal(45, 1), res)
+
+	_, err = newDecimal(math.MinInt64, 1).MulInt(-1)
+	require.ErrorIs(t, err, accounting.ErrOverflow)
+}
+
+func TestDecimal_Cmp(t *testing.T) {
+	for _, tc := range []struct {
+		x, y accounting.Decimal
+		exp  int
+	}{
+		{newDecimal(5, 1), newDecimal(50, 2), 0},
+		{newDecimal(5, 1), newDecimal(51, 2), -1},
+		{newDecimal(-5, 1), newDecimal(-51, 2), 1},
+		{newDecimal(0, 0), newDecimal(0, 30), 0},
+		{newDecimal(1, 0), newDecimal(math.MaxInt64, 30), 1},
+		{newDecimal(-1, 0), newDecimal(math.MinInt64, 30), -1},
+		{newDecimal(math.MaxInt64, 30), newDecimal(0, 0), 1},
+		{newDecimal(math.MinInt64, 30), newDecimal(0, 0), -1},
+	} {
+		require.Equal(t, tc.exp, tc.x.Cmp(tc.y), tc)
+		require.Equal(t, -tc.exp, tc.y.Cmp(tc.x), tc)
+	}
+}
+
+func TestDecimal_Rescale(t *testing.T) {
+	d := newDecimal(12500, 3)
+
+	res, err := d.Rescale(1)
+	require.NoError(t, err)
+	require.Equal(t, newDecimal(125, 1), res)
+
+	res, err = d.Rescale(5)
+	require.NoError(t, err)
+	require.Equal(t, newDecimal(1250000, 5), res)
+
+	_, err = d.Rescale(0)
+	require.ErrorIs(t, err, accounting.ErrPrecisionLoss)
+
+	_, err = d.Rescale(20)
+	require.ErrorIs(t, err, accounting.ErrOverflow)
+
+	require.Equal(t, newDecimal(12, 0), d.Truncate(0))
+	require.Equal(t, newDecimal(-12, 0), newDecimal(-12500, 3).Truncate(0))
+	require.Equal(t, d, d.Truncate(5))
+	require.Equal(t, newDecimal(0, 1), newDecimal(math.MaxInt64, 30).Truncate(1))
+}
+
+func TestDecimal_String(t *testing.T) {
+	for _, tc := range []struct {
+		d accounting.Decimal
+		s string
+	}{
+		{newDecimal(0, 0), "0"},
+		{newDecimal(0, 5), "0"},
+		{newDecimal(125, 1), "12.5"},
+		{newDecimal(12500, 3), "12.5"},
+		{newDecimal(-1, 3), "-0.001"},
+		{newDecimal(100, 0), "100"},
+		{newDecimal(math.MinInt64, 0), "-9223372036854775808"},
+		{newDecimal(math.MinInt64, 19), "-0.9223372036854775808"},
+	} {
+		require.Equal(t, tc.s, tc.d.String())
+		require.Equal(t, tc.s+" GAS", tc.d.Format("GAS"))
+	}
+
+	require.Equal(t, "12.5", newDecimal(125, 1).Format(""))
+}
+
+func TestDecimal_DecodeString(t *testing.T) {
+	var d accounting.Decimal
+
+	for _, tc := range []struct {
+		s   string
+		exp accounting.Decimal
+	}{
+		{"0", newDecimal(0, 0)},
+		{"12.50", newDecimal(1250, 2)},
+		{"-0.001", newDecimal(-1, 3)},
+		{"-9223372036854775808", newDecimal(math.MinInt64, 0)},
+	} {
+		require.NoError(t, d.DecodeString(tc.s), tc.s)
+		require.Equal(t, tc.exp, d, tc.s)
+	}
+
+	for _, s := range []string{
+		"", "-", ".5", "1.", "1.2.3", "+1", "1e5", " 1", "1,5",
+		"9223372036854775808",
+	} {
+		require.Error(t, d.DecodeString(s), s)
+	}
+
+	require.ErrorIs(t, d.DecodeString("9223372036854775808"), accounting.ErrOverflow)
+
+	require.NoError(t, d.DecodeStringWithUnit("12.5 GAS", "GAS"))
+	require.Equal(t, newDecimal(125, 1), d)
+
+	require.Error(t, d.DecodeStringWithUnit("12.5", "GAS"))
+	require.Error(t, d.DecodeStringWithUnit("12.5 NEO", "GAS"))
+	require.Error(t, d.DecodeStringWithUnit("12.5GAS", "GAS"))
+}
+
+func TestDecimal_Fixed8(t *testing.T) {
+	f, err := fixedn.Fixed8FromString("12.5")
+	require.NoError(t, err)
+
+	var d accounting.Decimal
+	d.SetFixed8(f)
+	require.Equal(t, newDecimal(1250000000, 8), d)
+	require.Equal(t, "12.5", d.String())
+
+	res, err := d.Fixed8()
+	require.NoError(t, err)
+	require.Equal(t, f, res)
+
+	res, err = newDecimal(125, 1).Fixed8()
+	require.NoError(t, err)
+	require.Equal(t, f, res)
+
+	_, err = newDecimal(1, 9).Fixed8()
+	require.ErrorIs(t, err, accounting.ErrPrecisionLoss)
+
+	_, err = newDecimal(math.MaxInt64, 0).Fixed8()
+	require.ErrorIs(t, err, accounting.ErrOverflow)
+}
@@ -8,6 +8,18 @@ working with Fixed8 balance precision:
 	dec.SetValue(val)
 	dec.SetPrecision(8)
 
+Decimal values of different precisions can be added, subtracted and compared
+exactly, and converted to and from human-readable strings:
+
+	var price accounting.Decimal
+	err := price.DecodeStringWithUnit("12.5 GAS", "GAS")
+	// ...
+
+	sum, err := dec.Add(price)
+	// ...
+
+	fmt.Println(sum.Format("GAS"))
+
 Instances can be also used to process NeoFS API V2 protocol messages
 (see neo.fs.v2.accounting package in https://github.com/nspcc-dev/neofs-api).
 
@@ -82,7 +82,8 @@ func (c *Client) BalanceGet(ctx context.Context, prm PrmBalanceGet) (*ResBalance
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.statusRes = &res
-	cc.call = func() (responseV2, error) {
+	cc.method = MethodBalanceGet
+	cc.call = func(ctx context.Context) (responseV2, error) {
 		return rpcapi.Balance(&c.c, &req, client.WithContext(ctx))
 	}
 	cc.result = func(r responseV2) {
@@ -103,7 +104,7 @@ func (c *Client) BalanceGet(ctx context.Context, prm PrmBalanceGet) (*ResBalance
 	}
 
 	// process call
-	if !cc.processCall() {
+	if !cc.processCall(ctx) {
 		return nil, cc.err
 	}
 
@@ -10,6 +10,7 @@ import (
 	v2accounting "github.com/nspcc-dev/neofs-api-go/v2/accounting"
 	"github.com/nspcc-dev/neofs-api-go/v2/rpc"
 	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-sdk-go/telemetry"
 )
 
 // Client represents virtual connection to the NeoFS network to communicate
@@ -49,6 +50,9 @@ type Client struct {
 	c client.Client
 
 	server neoFSAPIServer
+
+	// network address of the server set on Dial
+	endpoint string
 }
 
 // Init brings the Client instance to its initial state.
@@ -59,6 +63,12 @@ type Client struct {
 // See docs of PrmInit methods for details. See also Dial / Close.
 func (c *Client) Init(prm PrmInit) {
 	c.prm = prm
+
+	if prm.exporter != nil {
+		// spans are exported by the innermost interceptors to reflect pure RPC
+		c.prm.unaryInterceptors = append(prm.unaryInterceptors[:len(prm.unaryInterceptors):len(prm.unaryInterceptors)], c.exportUnary)
+		c.prm.streamInterceptors = append(prm.streamInterceptors[:len(prm.streamInterceptors):len(prm.streamInterceptors)], c.exportStream)
+	}
 }
 
 // Dial establishes a connection to the server from the NeoFS network.
@@ -104,6 +114,7 @@ func (c *Client) Dial(prm PrmDial) error {
 	)...)
 
 	c.setNeoFSAPIServer((*coreServer)(&c.c))
+	c.endpoint = prm.endpoint
 
 	if prm.parentCtx == nil {
 		prm.parentCtx = context.Background()
@@ -153,6 +164,12 @@ type PrmInit struct {
 	cbRespInfo func(ResponseMetaInfo) error
 
 	netMagic uint64
+
+	unaryInterceptors []UnaryInterceptor
+
+	streamInterceptors []StreamInterceptor
+
+	exporter telemetry.Exporter
 }
 
 // SetDefaultPrivateKey sets Client private key to be used for the protocol
@@ -177,6 +194,31 @@ func (x *PrmInit) SetResponseInfoCallback(f func(ResponseMetaInfo) error) {
 	x.cbRespInfo = f
 }
 
+// SetUnaryInterceptors sets chain of interceptors of the unary NeoFS API calls
+// (all except object reading/writing/search streams). The first interceptor is
+// the outermost one. Nil (default) means no interception.
+//
+// See also UnaryInterceptor.
+func (x *PrmInit) SetUnaryInterceptors(interceptors ...UnaryInterceptor) {
+	x.unaryInterceptors = interceptors
+}
+
+// SetStreamInterceptors sets chain of interceptors of the NeoFS API message
+// streams (see ObjectPutInit, ObjectGetInit, ObjectRangeInit and
+// ObjectSearchInit). The first interceptor is the outermost one. Nil (default)
+// means no interception.
+//
+// See also StreamInterceptor.
+func (x *PrmInit) SetStreamInterceptors(interceptors ...StreamInterceptor) {
+	x.streamInterceptors = interceptors
+}
+
+// SetExporter makes the Client to export telemetry.Span of each NeoFS API call
+// to e. Nil (default) means no export.
+func (x *PrmInit) SetExporter(e telemetry.Exporter) {
+	x.exporter = e
+}
+
 // PrmDial groups connection parameters for the Client.
 //
 // See also Dial.
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"fmt"
 
@@ -88,6 +89,9 @@ type contextCall struct {
 	// received response
 	resp responseV2
 
+	// status of the received response
+	st apistatus.Status
+
 	// ==================================================
 	// shared parameters which are set uniformly on all calls
 
@@ -106,17 +110,23 @@ type contextCall struct {
 	// Meta parameters
 	meta prmCommonMeta
 
+	// interceptors of the call
+	interceptors []UnaryInterceptor
+
 	// ==================================================
 	// custom call parameters
 
 	// structure of the call result
 	statusRes resCommon
 
+	// name of the called NeoFS API method
+	method string
+
 	// request to be signed with a key and sent
 	req request
 
 	// function to send a request (unary) and receive a response
-	call func() (responseV2, error)
+	call func(context.Context) (responseV2, error)
 
 	// function to send the request (req field)
 	wReq func() error
@@ -179,11 +189,9 @@ func (c *Client) prepareRequest(req request, meta *v2session.RequestMetaHeader)
 	req.SetMetaHeader(meta)
 }
 
-// prepares, signs and writes the request. Result means success.
+// signs and writes the prepared request. Result means success.
 // If failed, contextCall.err contains the reason.
 func (x *contextCall) writeRequest() bool {
-	x.prepareRequest()
-
 	x.req.SetVerificationHeader(nil)
 
 	// sign the request
@@ -233,16 +241,16 @@ func (x *contextCall) processResponse() bool {
 	}
 
 	// get result status
-	st := apistatus.FromStatusV2(x.resp.GetMetaHeader().GetStatus())
+	x.st = apistatus.FromStatusV2(x.resp.GetMetaHeader().GetStatus())
 
 	// unwrap unsuccessful status and return it
 	// as error if client has been configured so
-	successfulStatus := apistatus.IsSuccessful(st)
+	successfulStatus := apistatus.IsSuccessful(x.st)
 
 	if x.resolveAPIFailures {
-		x.err = apistatus.ErrFromStatus(st)
+		x.err = apistatus.ErrFromStatus(x.st)
 	} else {
-		x.statusRes.setStatus(st)
+		x.statusRes.setStatus(x.st)
 	}
 
 	return successfulStatus
@@ -297,24 +305,35 @@ func (x *contextCall) close() bool {
 
 // goes through all stages of sending a request and processing a response. Returns true if successful.
 // If failed, contextCall.err contains the reason.
-func (x *contextCall) processCall() bool {
-	// set request writer
-	x.wReq = func() error {
-		var err error
-		x.resp, err = x.call()
-		return err
-	}
+func (x *contextCall) processCall(ctx context.Context) bool {
+	x.prepareRequest()
 
-	// write request
-	ok := x.writeRequest()
-	if !ok {
-		return false
-	}
+	var ok bool
 
-	// read response
-	ok = x.readResponse()
-	if !ok {
-		return x.err == nil
+	x.err = interceptUnary(ctx, x.interceptors, x.method, x.req, func(ctx context.Context) (responseV2, apistatus.Status, error) {
+		// set request writer
+		x.wReq = func() error {
+			var err error
+			x.resp, err = x.call(ctx)
+			return err
+		}
+
+		x.st = nil
+
+		// write request
+		if !x.writeRequest() {
+			return nil, nil, x.err
+		}
+
+		// read response
+		ok = x.readResponse()
+
+		return x.resp, x.st, x.err
+	})
+	if x.err != nil {
+		return false
+	} else if !ok {
+		return true
 	}
 
 	// close and write response to resulting structure
@@ -332,6 +351,7 @@ func (c *Client) initCallContext(ctx *contextCall) {
 	ctx.resolveAPIFailures = c.prm.resolveNeoFSErrors
 	ctx.callbackResp = c.prm.cbRespInfo
 	ctx.netMagic = c.prm.netMagic
+	ctx.interceptors = c.prm.unaryInterceptors
 }
 
 // ExecRaw executes f with underlying github.com/nspcc-dev/neofs-api-go/v2/rpc/client.Client
@@ -139,7 +139,8 @@ func (c *Client) ContainerPut(ctx context.Context, prm PrmContainerPut) (*ResCon
 	c.initCallContext(&cc)
 	cc.req = &req
 	cc.statusRes = &res
-	cc.call = func() (responseV2, error) {
+	cc.method = MethodContainerPut
+	cc.call = func(ctx context.Context) (responseV2, error) {
 		return rpcapi.PutContainer(&c.c, &req, client.WithContext(ctx))
 	}
 	cc.result = func(r responseV2) {
@@ -160,7 +161,7 @@ func (c *Client) ContainerPut(ctx context.Context, prm PrmContainerPut) (*ResCon
 	}
 
 	// process call
-	if !cc.processCall() {
+	if !cc.processCall(ctx) {
 		return nil, cc.err
 	}
 
@@ -241,7 +242,8 @@ func (c *Client) ContainerGet(ctx context.Context, prm PrmContainerGet) (*ResCon
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.statusRes = &res
-	cc.call = func() (responseV2, error) {
+	cc.method = MethodContainerGet
+	cc.call = func(ctx context.Context) (responseV2, error) {
 		return rpcapi.GetContainer(&c.c, &req, client.WithContext(ctx))
 	}
 	cc.result = func(r responseV2) {
@@ -260,7 +262,7 @@ func (c *Client) ContainerGet(ctx context.Context, prm PrmContainerGet) (*ResCon
 	}
 
 	// process call
-	if !cc.processCall() {
+	if !cc.processCall(ctx) {
 		return nil, cc.err
 	}
 
@@ -341,7 +343,8 @@ func (c *Client) ContainerList(ctx context.Context, prm PrmContainerList) (*ResC
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.statusRes = &res
-	cc.call = func() (responseV2, error) {
+	cc.method = MethodContainerList
+	cc.call = func(ctx context.Context) (responseV2, error) {
 		return rpcapi.ListContainers(&c.c, &req, client.WithContext(ctx))
 	}
 	cc.result = func(r responseV2) {
@@ -359,7 +362,7 @@ func (c *Client) ContainerList(ctx context.Context, prm PrmContainerList) (*ResC
 	}
 
 	// process call
-	if !cc.processCall() {
+	if !cc.processCall(ctx) {
 		return nil, cc.err
 	}
 
@@ -481,12 +484,13 @@ func (c *Client) ContainerDelete(ctx context.Context, prm PrmContainerDelete) (*
 	c.initCallContext(&cc)
 	cc.req = &req
 	cc.statusRes = &res
-	cc.call = func() (responseV2, error) {
+	cc.method = MethodContainerDelete
+	cc.call = func(ctx context.Context) (responseV2, error) {
 		return rpcapi.DeleteContainer(&c.c, &req, client.WithContext(ctx))
 	}
 
 	// process call
-	if !cc.processCall() {
+	if !cc.processCall(ctx) {
 		return nil, cc.err
 	}
 
@@ -567,7 +571,8 @@ func (c *Client) ContainerEACL(ctx context.Context, prm PrmContainerEACL) (*ResC
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.statusRes = &res
-	cc.call = func() (responseV2, error) {
+	cc.method = MethodContainerEACL
+	cc.call = func(ctx context.Context) (responseV2, error) {
 		return rpcapi.GetEACL(&c.c, &req, client.WithContext(ctx))
 	}
 	cc.result = func(r responseV2) {
@@ -583,7 +588,7 @@ func (c *Client) ContainerEACL(ctx context.Context, prm PrmContainerEACL) (*ResC
 	}
 
 	// process call
-	if !cc.processCall() {
+	if !cc.processCall(ctx) {
 		return nil, cc.err
 	}
 
@@ -702,12 +707,13 @@ func (c *Client) ContainerSetEACL(ctx context.Context, prm PrmContainerSetEACL)
 	c.initCallContext(&cc)
 	cc.req = &req
 	cc.statusRes = &res
-	cc.call = func() (responseV2, error) {
+	cc.method = MethodContainerSetEACL
+	cc.call = func(ctx context.Context) (responseV2, error) {
 		return rpcapi.SetEACL(&c.c, &req, client.WithContext(ctx))
 	}
 
 	// process call
-	if !cc.processCall() {
+	if !cc.processCall(ctx) {
 		return nil, cc.err
 	}
 
@@ -787,12 +793,13 @@ func (c *Client) ContainerAnnounceUsedSpace(ctx context.Context, prm PrmAnnounce
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.statusRes = &res
-	cc.call = func() (responseV2, error) {
+	cc.method = MethodContainerAnnounce
+	cc.call = func(ctx context.Context) (responseV2, error) {
 		return rpcapi.AnnounceUsedSpace(&c.c, &req, client.WithContext(ctx))
 	}
 
 	// process call
-	if !cc.processCall() {
+	if !cc.processCall(ctx) {
 		return nil, cc.err
 	}
 
@@ -7,91 +7,52 @@ import (
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 )
 
-// unwraps err using errors.Unwrap and returns the result.
-func unwrapErr(err error) error {
-	for e := errors.Unwrap(err); e != nil; e = errors.Unwrap(err) {
-		err = e
-	}
-
-	return err
-}
-
 // IsErrContainerNotFound checks if err corresponds to NeoFS status
 // return corresponding to missing container. Supports wrapped errors.
 func IsErrContainerNotFound(err error) bool {
-	switch unwrapErr(err).(type) {
-	default:
-		return false
-	case
-		apistatus.ContainerNotFound,
-		*apistatus.ContainerNotFound:
-		return true
-	}
+	return errors.Is(err, apistatus.ErrContainerNotFound)
 }
 
 // IsErrEACLNotFound checks if err corresponds to NeoFS status
 // return corresponding to missing eACL table. Supports wrapped errors.
 func IsErrEACLNotFound(err error) bool {
-	switch unwrapErr(err).(type) {
-	default:
-		return false
-	case
-		apistatus.EACLNotFound,
-		*apistatus.EACLNotFound:
-		return true
-	}
+	return errors.Is(err, apistatus.ErrEACLNotFound)
 }
 
 // IsErrObjectNotFound checks if err corresponds to NeoFS status
 // return corresponding to missing object. Supports wrapped errors.
 func IsErrObjectNotFound(err error) bool {
-	switch unwrapErr(err).(type) {
-	default:
-		return false
-	case
-		apistatus.ObjectNotFound,
-		*apistatus.ObjectNotFound:
-		return true
-	}
+	return errors.Is(err, apistatus.ErrObjectNotFound)
 }
 
 // IsErrObjectAlreadyRemoved checks if err corresponds to NeoFS status
 // return corresponding to already removed object. Supports wrapped errors.
 func IsErrObjectAlreadyRemoved(err error) bool {
-	switch unwrapErr(err).(type) {
-	default:
-		return false
-	case
-		apistatus.ObjectAlreadyRemoved,
-		*apistatus.ObjectAlreadyRemoved:
-		return true
-	}
+	return errors.Is(err, apistatus.ErrObjectAlreadyRemoved)
+}
+
+// IsErrObjectLocked checks if err corresponds to NeoFS status return
+// corresponding to the attempt to remove locked object. Supports wrapped errors.
+func IsErrObjectLocked(err error) bool {
+	return errors.Is(err, apistatus.ErrObjectLocked)
+}
+
+// IsErrLockNonRegularObject checks if err corresponds to NeoFS status return
+// corresponding to the attempt to lock non-regular object. Supports wrapped errors.
+func IsErrLockNonRegularObject(err error) bool {
+	return errors.Is(err, apistatus.ErrLockNonRegularObject)
 }
 
 // IsErrSessionExpired checks if err corresponds to NeoFS status return
 // corresponding to expired session. Supports wrapped errors.
 func IsErrSessionExpired(err error) bool {
-	switch unwrapErr(err).(type) {
-	default:
-		return false
-	case
-		apistatus.SessionTokenExpired,
-		*apistatus.SessionTokenExpired:
-		return true
-	}
+	return errors.Is(err, apistatus.ErrSessionTokenExpired)
 }
 
 // IsErrSessionNotFound checks if err corresponds to NeoFS status return
 // corresponding to missing session. Supports wrapped errors.
 func IsErrSessionNotFound(err error) bool {
-	switch unwrapErr(err).(type) {
-	default:
-		return false
-	case
-		apistatus.SessionTokenNotFound,
-		*apistatus.SessionTokenNotFound:
-		return true
-	}
+	return errors.Is(err, apistatus.ErrSessionTokenNotFound)
 }
 
 // returns error describing missing field with the given name.
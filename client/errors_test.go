@@ -42,6 +42,20 @@ func TestErrors(t *testing.T) {
 				new(apistatus.ObjectAlreadyRemoved),
 			},
 		},
+		{
+			check: client.IsErrObjectLocked,
+			errs: []error{
+				apistatus.ObjectLocked{},
+				new(apistatus.ObjectLocked),
+			},
+		},
+		{
+			check: client.IsErrLockNonRegularObject,
+			errs: []error{
+				apistatus.LockNonRegularObject{},
+				new(apistatus.LockNonRegularObject),
+			},
+		},
 		{
 			check: client.IsErrSessionExpired,
 			errs: []error{
@@ -0,0 +1,332 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/grpc"
+	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Names of the NeoFS API methods passed to the interceptors (see CallInfo).
+const (
+	MethodBalanceGet           = "/neo.fs.v2.accounting.AccountingService/Balance"
+	MethodContainerPut         = "/neo.fs.v2.container.ContainerService/Put"
+	MethodContainerGet         = "/neo.fs.v2.container.ContainerService/Get"
+	MethodContainerList        = "/neo.fs.v2.container.ContainerService/List"
+	MethodContainerDelete      = "/neo.fs.v2.container.ContainerService/Delete"
+	MethodContainerEACL        = "/neo.fs.v2.container.ContainerService/GetExtendedACL"
+	MethodContainerSetEACL     = "/neo.fs.v2.container.ContainerService/SetExtendedACL"
+	MethodContainerAnnounce    = "/neo.fs.v2.container.ContainerService/AnnounceUsedSpace"
+	MethodEndpointInfo         = "/neo.fs.v2.netmap.NetmapService/LocalNodeInfo"
+	MethodNetworkInfo          = "/neo.fs.v2.netmap.NetmapService/NetworkInfo"
+	MethodNetMapSnapshot       = "/neo.fs.v2.netmap.NetmapService/NetmapSnapshot"
+	MethodObjectPut            = "/neo.fs.v2.object.ObjectService/Put"
+	MethodObjectGet            = "/neo.fs.v2.object.ObjectService/Get"
+	MethodObjectHead           = "/neo.fs.v2.object.ObjectService/Head"
+	MethodObjectRange          = "/neo.fs.v2.object.ObjectService/GetRange"
+	MethodObjectHash           = "/neo.fs.v2.object.ObjectService/GetRangeHash"
+	MethodObjectDelete         = "/neo.fs.v2.object.ObjectService/Delete"
+	MethodObjectSearch         = "/neo.fs.v2.object.ObjectService/Search"
+	MethodAnnounceLocalTrust   = "/neo.fs.v2.reputation.ReputationService/AnnounceLocalTrust"
+	MethodAnnounceIntermediate = "/neo.fs.v2.reputation.ReputationService/AnnounceIntermediateResult"
+	MethodSessionCreate        = "/neo.fs.v2.session.SessionService/Create"
+)
+
+// CallInfo describes NeoFS API call passed to the interceptors.
+type CallInfo struct {
+	method string
+
+	meta *v2session.RequestMetaHeader
+}
+
+// Method returns full name of the called NeoFS API method (one of Method* constants).
+func (x CallInfo) Method() string {
+	return x.method
+}
+
+// MetaHeader returns meta header of the request. Header can be modified by
+// the interceptor before the call is invoked: it is signed after all the
+// interceptors pass the call further.
+func (x CallInfo) MetaHeader() *v2session.RequestMetaHeader {
+	return x.meta
+}
+
+// CallResult describes completed NeoFS API call.
+type CallResult struct {
+	st apistatus.Status
+
+	elapsed time.Duration
+
+	sent, received int
+
+	sentBytes, receivedBytes int
+}
+
+// Status returns decoded status of the last received response. Returns nil
+// if no response has been processed (e.g. on transport errors).
+func (x CallResult) Status() apistatus.Status {
+	return x.st
+}
+
+// Elapsed returns duration of the call. For streams, it is a time between
+// stream opening and closing.
+func (x CallResult) Elapsed() time.Duration {
+	return x.elapsed
+}
+
+// SentMessages returns number of request messages written by the client.
+func (x CallResult) SentMessages() int {
+	return x.sent
+}
+
+// ReceivedMessages returns number of response messages read by the client.
+func (x CallResult) ReceivedMessages() int {
+	return x.received
+}
+
+// SentBytes returns total size of the encoded request messages written by the
+// client.
+func (x CallResult) SentBytes() int {
+	return x.sentBytes
+}
+
+// ReceivedBytes returns total size of the encoded response messages read by
+// the client.
+func (x CallResult) ReceivedBytes() int {
+	return x.receivedBytes
+}
+
+// UnaryInvoker continues unary call: passes it to the next interceptor or
+// sends the request and processes the response. Context is used for network
+// communication.
+type UnaryInvoker func(ctx context.Context) (CallResult, error)
+
+// UnaryInterceptor intercepts unary NeoFS API calls of the Client.
+//
+// Interceptor may modify request meta header (see CallInfo.MetaHeader) and
+// context before invoking the call, or return an error without calling invoke
+// at all in order to short-circuit the call. The error returned from the
+// interceptor replaces the one returned by invoke, however, the call failure
+// can not be suppressed: if interceptor returns nil, the original error is
+// returned from the Client method.
+//
+// Invoke may be called several times (e.g. for retries), in this case the
+// result of the last call is used.
+type UnaryInterceptor func(ctx context.Context, info CallInfo, invoke UnaryInvoker) error
+
+// StreamOpener continues stream opening: passes it to the next interceptor or
+// opens the stream. Context is used for network communication during the whole
+// stream lifetime.
+type StreamOpener func(ctx context.Context) error
+
+// StreamInterceptor intercepts opening of the NeoFS API message streams of the
+// Client (e.g. ObjectGetInit).
+//
+// Interceptor may modify request meta header (see CallInfo.MetaHeader) and
+// context before opening the stream, or return an error without calling open
+// in order to short-circuit the call. If interceptor returns a non-nil
+// function, it is called once when the stream is finished (closed or failed
+// to open) with the stream result and the final error.
+type StreamInterceptor func(ctx context.Context, info CallInfo, open StreamOpener) (func(CallResult, error), error)
+
+// errCallNotInvoked is returned when interceptor neither invokes the call nor
+// returns an error.
+var errCallNotInvoked = errors.New("call was interrupted by interceptor without an error")
+
+// messageSize returns size of the encoded NeoFS API message.
+func messageSize(m interface{}) int {
+	if gm, ok := m.(interface{ ToGRPCMessage() grpc.Message }); ok {
+		if pm, ok := gm.ToGRPCMessage().(proto.Message); ok {
+			return proto.Size(pm)
+		}
+	}
+
+	return 0
+}
+
+// interceptUnary passes unary call through the interceptors. The call signs and
+// sends the request, and returns the received response along with its status.
+func interceptUnary(ctx context.Context, interceptors []UnaryInterceptor, method string, req request,
+	call func(context.Context) (responseV2, apistatus.Status, error)) error {
+	if len(interceptors) == 0 {
+		_, _, err := call(ctx)
+		return err
+	}
+
+	var (
+		invoked bool
+		callErr error
+	)
+
+	invoke := func(ctx context.Context) (CallResult, error) {
+		var res CallResult
+
+		invoked = true
+		start := time.Now()
+
+		resp, st, err := call(ctx)
+		res.st, callErr = st, err
+		res.elapsed = time.Since(start)
+		res.sent = 1
+		res.sentBytes = messageSize(req)
+
+		if resp != nil {
+			res.received = 1
+			res.receivedBytes = messageSize(resp)
+		}
+
+		return res, callErr
+	}
+
+	info := CallInfo{
+		method: method,
+		meta:   req.GetMetaHeader(),
+	}
+
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		next, f := invoke, interceptors[i]
+
+		invoke = func(ctx context.Context) (CallResult, error) {
+			var res CallResult
+
+			err := f(ctx, info, func(ctx context.Context) (CallResult, error) {
+				var err error
+				res, err = next(ctx)
+				return res, err
+			})
+
+			return res, err
+		}
+	}
+
+	_, err := invoke(ctx)
+
+	switch {
+	case err != nil:
+		return err
+	case !invoked:
+		return errCallNotInvoked
+	default:
+		return callErr
+	}
+}
+
+// streamCall tracks intercepted message stream.
+type streamCall struct {
+	start time.Time
+
+	sent, received int
+
+	sentBytes, receivedBytes int
+
+	// handlers of the stream end collected from the interceptors
+	finish []func(CallResult, error)
+}
+
+// send registers message written to the stream.
+func (x *streamCall) send(m interface{}) {
+	x.sent++
+
+	if len(x.finish) > 0 {
+		x.sentBytes += messageSize(m)
+	}
+}
+
+// recv registers message read from the stream.
+func (x *streamCall) recv(m interface{}) {
+	x.received++
+
+	if len(x.finish) > 0 {
+		x.receivedBytes += messageSize(m)
+	}
+}
+
+// end calls stream end handlers once. io.EOF is treated as a normal stream
+// termination.
+func (x *streamCall) end(st apistatus.Status, err error) {
+	if len(x.finish) == 0 {
+		return
+	}
+
+	if errors.Is(err, io.EOF) {
+		err = nil
+	}
+
+	res := CallResult{
+		st:            st,
+		elapsed:       time.Since(x.start),
+		sent:          x.sent,
+		received:      x.received,
+		sentBytes:     x.sentBytes,
+		receivedBytes: x.receivedBytes,
+	}
+
+	for i := range x.finish {
+		x.finish[i](res, err)
+	}
+
+	x.finish = nil
+}
+
+// interceptStream passes stream opening through the interceptors.
+func interceptStream(ctx context.Context, interceptors []StreamInterceptor, method string, meta *v2session.RequestMetaHeader,
+	open func(context.Context) error) (streamCall, error) {
+	call := streamCall{
+		start: time.Now(),
+	}
+
+	if len(interceptors) == 0 {
+		return call, open(ctx)
+	}
+
+	var (
+		invoked bool
+		openErr error
+	)
+
+	invoke := func(ctx context.Context) error {
+		invoked = true
+		call.start = time.Now()
+		openErr = open(ctx)
+		return openErr
+	}
+
+	info := CallInfo{
+		method: method,
+		meta:   meta,
+	}
+
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		next, f := invoke, interceptors[i]
+
+		invoke = func(ctx context.Context) error {
+			fin, err := f(ctx, info, next)
+			if fin != nil {
+				call.finish = append(call.finish, fin)
+			}
+
+			return err
+		}
+	}
+
+	err := invoke(ctx)
+
+	switch {
+	case err != nil:
+	case !invoked:
+		err = errCallNotInvoked
+	default:
+		err = openErr
+	}
+
+	if err != nil {
+		call.end(nil, err)
+	}
+
+	return call, err
+}
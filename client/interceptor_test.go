@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_UnaryInterceptors(t *testing.T) {
+	srv := serverNetMap{signResponse: true}
+	c := newClient(&srv)
+	ctx := context.Background()
+
+	var (
+		order []string
+		info  CallInfo
+		res   CallResult
+	)
+
+	c.prm.SetUnaryInterceptors(
+		func(ctx context.Context, i CallInfo, invoke UnaryInvoker) error {
+			order = append(order, "outer")
+			info = i
+
+			var err error
+			res, err = invoke(ctx)
+
+			return err
+		},
+		func(ctx context.Context, i CallInfo, invoke UnaryInvoker) error {
+			order = append(order, "inner")
+
+			i.MetaHeader().SetTTL(1)
+
+			_, err := invoke(ctx)
+			return err
+		},
+	)
+
+	resNetMap, err := c.NetMapSnapshot(ctx, PrmNetMapSnapshot{})
+	require.NoError(t, err)
+	assertStatusErr(t, resNetMap)
+
+	require.Equal(t, []string{"outer", "inner"}, order)
+	require.Equal(t, MethodNetMapSnapshot, info.Method())
+	require.EqualValues(t, 1, info.MetaHeader().GetTTL())
+	assertStatusErr(t, res)
+	require.Equal(t, 1, res.SentMessages())
+	require.Equal(t, 1, res.ReceivedMessages())
+	require.Positive(t, res.Elapsed())
+
+	t.Run("short-circuit", func(t *testing.T) {
+		errBreak := errors.New("break")
+
+		c.prm.SetUnaryInterceptors(func(context.Context, CallInfo, UnaryInvoker) error {
+			return errBreak
+		})
+
+		_, err := c.NetMapSnapshot(ctx, PrmNetMapSnapshot{})
+		require.ErrorIs(t, err, errBreak)
+
+		c.prm.SetUnaryInterceptors(func(context.Context, CallInfo, UnaryInvoker) error {
+			return nil
+		})
+
+		_, err = c.NetMapSnapshot(ctx, PrmNetMapSnapshot{})
+		require.ErrorIs(t, err, errCallNotInvoked)
+	})
+
+	t.Run("failure suppression", func(t *testing.T) {
+		srv.errTransport = errors.New("any error")
+		defer func() { srv.errTransport = nil }()
+
+		var st apistatus.Status
+
+		c.prm.SetUnaryInterceptors(func(ctx context.Context, _ CallInfo, invoke UnaryInvoker) error {
+			res, _ := invoke(ctx)
+			st = res.Status()
+			return nil
+		})
+
+		_, err := c.NetMapSnapshot(ctx, PrmNetMapSnapshot{})
+		require.ErrorIs(t, err, srv.errTransport)
+		require.Nil(t, st)
+	})
+}
+
+func TestClient_StreamInterceptors(t *testing.T) {
+	ids := make([]oid.ID, 3)
+	for i := range ids {
+		ids[i] = oidtest.ID()
+	}
+
+	p, r := testListReaderResponse(t)
+
+	var (
+		meta     v2session.RequestMetaHeader
+		finished int
+		res      CallResult
+		resErr   error
+	)
+
+	interceptors := []StreamInterceptor{
+		func(ctx context.Context, info CallInfo, open StreamOpener) (func(CallResult, error), error) {
+			require.Equal(t, MethodObjectSearch, info.Method())
+			require.Equal(t, &meta, info.MetaHeader())
+
+			return func(r CallResult, err error) {
+				finished++
+				res, resErr = r, err
+			}, open(ctx)
+		},
+	}
+
+	var err error
+
+	r.call, err = interceptStream(context.Background(), interceptors, MethodObjectSearch, &meta, func(context.Context) error {
+		r.stream = newSearchStream(p, io.EOF, ids[:2], ids[2:])
+		return nil
+	})
+	require.NoError(t, err)
+
+	r.call.send(new(v2object.SearchRequest))
+
+	var actual []oid.ID
+	require.NoError(t, r.Iterate(func(id oid.ID) bool {
+		actual = append(actual, id)
+		return false
+	}))
+	require.Equal(t, ids, actual)
+
+	_, err = r.Close()
+	require.NoError(t, err)
+
+	require.Equal(t, 1, finished)
+	require.NoError(t, resErr)
+	require.Equal(t, 1, res.SentMessages())
+	require.Equal(t, 2, res.ReceivedMessages())
+	require.Positive(t, res.ReceivedBytes())
+
+	t.Run("open failure", func(t *testing.T) {
+		errOpen := errors.New("any error")
+
+		finished = 0
+
+		_, err := interceptStream(context.Background(), interceptors, MethodObjectSearch, &meta, func(context.Context) error {
+			return errOpen
+		})
+		require.ErrorIs(t, err, errOpen)
+		require.Equal(t, 1, finished)
+		require.ErrorIs(t, resErr, errOpen)
+	})
+}
@@ -75,7 +75,8 @@ func (c *Client) EndpointInfo(ctx context.Context, prm PrmEndpointInfo) (*ResEnd
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.statusRes = &res
-	cc.call = func() (responseV2, error) {
+	cc.method = MethodEndpointInfo
+	cc.call = func(ctx context.Context) (responseV2, error) {
 		return rpcapi.LocalNodeInfo(&c.c, &req, client.WithContext(ctx))
 	}
 	cc.result = func(r responseV2) {
@@ -113,7 +114,7 @@ func (c *Client) EndpointInfo(ctx context.Context, prm PrmEndpointInfo) (*ResEnd
 	}
 
 	// process call
-	if !cc.processCall() {
+	if !cc.processCall(ctx) {
 		return nil, cc.err
 	}
 
@@ -172,7 +173,8 @@ func (c *Client) NetworkInfo(ctx context.Context, prm PrmNetworkInfo) (*ResNetwo
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.statusRes = &res
-	cc.call = func() (responseV2, error) {
+	cc.method = MethodNetworkInfo
+	cc.call = func(ctx context.Context) (responseV2, error) {
 		return rpcapi.NetworkInfo(&c.c, &req, client.WithContext(ctx))
 	}
 	cc.result = func(r responseV2) {
@@ -194,7 +196,7 @@ func (c *Client) NetworkInfo(ctx context.Context, prm PrmNetworkInfo) (*ResNetwo
 	}
 
 	// process call
-	if !cc.processCall() {
+	if !cc.processCall(ctx) {
 		return nil, cc.err
 	}
 
@@ -248,18 +250,27 @@ func (c *Client) NetMapSnapshot(ctx context.Context, _ PrmNetMapSnapshot) (*ResN
 	req.SetBody(&body)
 	c.prepareRequest(&req, &meta)
 
-	err := signature.SignServiceMessage(&c.prm.key, &req)
-	if err != nil {
-		return nil, fmt.Errorf("sign request: %w", err)
-	}
+	var (
+		res  ResNetMapSnapshot
+		resp *v2netmap.SnapshotResponse
+	)
 
-	resp, err := c.server.netMapSnapshot(ctx, req)
-	if err != nil {
-		return nil, err
-	}
+	err := interceptUnary(ctx, c.prm.unaryInterceptors, MethodNetMapSnapshot, &req, func(ctx context.Context) (responseV2, apistatus.Status, error) {
+		req.SetVerificationHeader(nil)
+
+		err := signature.SignServiceMessage(&c.prm.key, &req)
+		if err != nil {
+			return nil, nil, fmt.Errorf("sign request: %w", err)
+		}
+
+		resp, err = c.server.netMapSnapshot(ctx, req)
+		if err != nil {
+			return nil, nil, err
+		}
 
-	var res ResNetMapSnapshot
-	res.st, err = c.processResponse(resp)
+		res.st, err = c.processResponse(resp)
+		return resp, res.st, err
+	})
 	if err != nil {
 		return nil, err
 	}
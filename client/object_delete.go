@@ -146,18 +146,27 @@ func (c *Client) ObjectDelete(ctx context.Context, prm PrmObjectDelete) (*ResObj
 		key = prm.key
 	}
 
-	err := signature.SignServiceMessage(&key, &req)
-	if err != nil {
-		return nil, fmt.Errorf("sign request: %w", err)
-	}
-
-	resp, err := rpcapi.DeleteObject(&c.c, &req, client.WithContext(ctx))
-	if err != nil {
-		return nil, err
-	}
-
-	var res ResObjectDelete
-	res.st, err = c.processResponse(resp)
+	var (
+		res  ResObjectDelete
+		resp *v2object.DeleteResponse
+	)
+
+	err := interceptUnary(ctx, c.prm.unaryInterceptors, MethodObjectDelete, &req, func(ctx context.Context) (responseV2, apistatus.Status, error) {
+		req.SetVerificationHeader(nil)
+
+		err := signature.SignServiceMessage(&key, &req)
+		if err != nil {
+			return nil, nil, fmt.Errorf("sign request: %w", err)
+		}
+
+		resp, err = rpcapi.DeleteObject(&c.c, &req, client.WithContext(ctx))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		res.st, err = c.processResponse(resp)
+		return resp, res.st, err
+	})
 	if err != nil {
 		return nil, err
 	}
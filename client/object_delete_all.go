@@ -0,0 +1,287 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/deletion"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/relations"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+)
+
+// PrmObjectDeleteAll groups parameters of ObjectDeleteAll operation.
+type PrmObjectDeleteAll struct {
+	prmObjectCommon
+
+	session *session.Object
+
+	ids []oid.ID
+
+	prm deletion.Prm
+}
+
+// WithinSession specifies session within which objects should be removed.
+// Session must allow storing the tombstones in the container.
+//
+// Must be signed.
+func (x *PrmObjectDeleteAll) WithinSession(t session.Object) {
+	x.session = &t
+}
+
+// ByIDs specifies identifiers of the objects to be removed.
+// Required parameter.
+//
+// Slice must not be mutated until the operation completes.
+func (x *PrmObjectDeleteAll) ByIDs(ids ...oid.ID) {
+	x.ids = ids
+}
+
+// SetTombstoneLifetime sets number of epochs after the current one during
+// which tombstones are stored. Defaults to deletion.DefaultTombstoneLifetime.
+func (x *PrmObjectDeleteAll) SetTombstoneLifetime(epochs uint64) {
+	x.prm.SetTombstoneLifetime(epochs)
+}
+
+// SetTombstoneExpirationEpoch sets the last epoch of the tombstones lifetime.
+// Overrides SetTombstoneLifetime.
+func (x *PrmObjectDeleteAll) SetTombstoneExpirationEpoch(epoch uint64) {
+	x.prm.SetExpirationEpoch(epoch)
+}
+
+// SetMaxTombstoneMembers limits number of objects per tombstone. Defaults to
+// deletion.DefaultMaxMembers.
+func (x *PrmObjectDeleteAll) SetMaxTombstoneMembers(n int) {
+	x.prm.SetMaxMembers(n)
+}
+
+// ResObjectDeleteAll groups resulting values of ObjectDeleteAll operation.
+type ResObjectDeleteAll struct {
+	res []deletion.Result
+}
+
+// Results returns deletion outcomes of the requested objects in the order
+// they were specified in parameters.
+func (x ResObjectDeleteAll) Results() []deletion.Result {
+	return x.res
+}
+
+// ObjectDeleteAll removes the objects from the container along with all
+// physical objects of their split hierarchies (see deletion.Delete). Unlike
+// ObjectDelete, tombstones are formed and signed on the client side, and
+// multiple objects are grouped into single tombstone.
+//
+// Outcome of each object is returned in the result, error is returned only
+// if the operation could not be started. Objects which are already removed,
+// missing or locked are reported with the corresponding deletion.Status.
+//
+// Immediately panics if parameters are set incorrectly (see PrmObjectDeleteAll docs).
+// Context is required and must not be nil. It is used for network communication.
+func (c *Client) ObjectDeleteAll(ctx context.Context, prm PrmObjectDeleteAll) (*ResObjectDeleteAll, error) {
+	switch {
+	case ctx == nil:
+		panic(panicMsgMissingContext)
+	case !prm.cnrSet:
+		panic(panicMsgMissingContainer)
+	case len(prm.ids) == 0:
+		panic(panicMsgMissingObject)
+	}
+
+	prm.prm.SetTokens(relations.Tokens{
+		Session: prm.session,
+		Bearer:  prm.bearer,
+	})
+
+	res, err := deletion.Delete(ctx, deletionStorage{
+		c:   c,
+		prm: prm.prmObjectCommon,
+	}, prm.cnr, prm.ids, prm.prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResObjectDeleteAll{
+		res: res,
+	}, nil
+}
+
+// deletionStorage implements deletion.Storage through the Client. All
+// unsuccessful NeoFS statuses are returned as errors.
+type deletionStorage struct {
+	c *Client
+
+	prm prmObjectCommon
+}
+
+// CurrentEpoch implements deletion.Storage.
+func (x deletionStorage) CurrentEpoch(ctx context.Context) (uint64, error) {
+	res, err := x.c.networkInfo(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Info().CurrentEpoch(), nil
+}
+
+// PutTombstone implements deletion.Storage.
+func (x deletionStorage) PutTombstone(ctx context.Context, cnrID cid.ID, ts object.Tombstone, tokens relations.Tokens) (oid.ID, error) {
+	payload, err := ts.Marshal()
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("encode tombstone: %w", err)
+	}
+
+	prm := x.prm
+	prm.FromContainer(cnrID)
+	prm.bearer = tokens.Bearer
+
+	id, err := x.c.putSystemObject(ctx, prm, tokens.Session, object.TypeTombstone, payload, ts.ExpirationEpoch())
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("write TOMBSTONE object: %w", err)
+	}
+
+	return id, nil
+}
+
+// head reads header of the object with the given address.
+func (x deletionStorage) head(ctx context.Context, cnrID cid.ID, objID oid.ID, raw bool, tokens relations.Tokens) (object.Object, error) {
+	var prm PrmObjectHead
+	prm.FromContainer(cnrID)
+	prm.ByID(objID)
+
+	if x.prm.key != nil {
+		prm.UseKey(*x.prm.key)
+	}
+
+	if tokens.Bearer != nil {
+		prm.WithBearerToken(*tokens.Bearer)
+	}
+
+	if tokens.Session != nil {
+		prm.WithinSession(*tokens.Session)
+	}
+
+	if raw {
+		prm.MarkRaw()
+	}
+
+	var hdr object.Object
+
+	res, err := x.c.ObjectHead(ctx, prm)
+	if err == nil {
+		err = apistatus.ErrFromStatus(res.Status())
+	}
+
+	if err != nil {
+		return hdr, err
+	}
+
+	if !res.ReadHeader(&hdr) {
+		return hdr, errors.New("missing object header in the response")
+	}
+
+	return hdr, nil
+}
+
+// search selects objects from the container which match the given filters.
+func (x deletionStorage) search(ctx context.Context, cnrID cid.ID, filters object.SearchFilters, tokens relations.Tokens) ([]oid.ID, error) {
+	var prm PrmObjectSearch
+	prm.InContainer(cnrID)
+	prm.SetFilters(filters)
+	prm.key = x.prm.key
+
+	if tokens.Bearer != nil {
+		prm.WithBearerToken(*tokens.Bearer)
+	}
+
+	if tokens.Session != nil {
+		prm.WithinSession(*tokens.Session)
+	}
+
+	r, err := x.c.ObjectSearchInit(ctx, prm)
+	if err != nil {
+		return nil, fmt.Errorf("init object search: %w", err)
+	}
+
+	var res []oid.ID
+
+	err = r.Iterate(func(id oid.ID) bool {
+		res = append(res, id)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate found objects: %w", err)
+	}
+
+	return res, nil
+}
+
+// GetSplitInfo implements relations.Relations.
+func (x deletionStorage) GetSplitInfo(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) (*object.SplitInfo, error) {
+	_, err := x.head(ctx, cnrID, objID, true, tokens)
+
+	var errSplit *object.SplitInfoError
+
+	switch {
+	case errors.As(err, &errSplit):
+		return errSplit.SplitInfo(), nil
+	case err == nil:
+		return nil, relations.ErrNoSplitInfo
+	default:
+		return nil, fmt.Errorf("failed to get raw object header: %w", err)
+	}
+}
+
+// ListChildrenByLinker implements relations.Relations.
+func (x deletionStorage) ListChildrenByLinker(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) ([]oid.ID, error) {
+	hdr, err := x.head(ctx, cnrID, objID, false, tokens)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get linking object's header: %w", err)
+	}
+
+	return hdr.Children(), nil
+}
+
+// GetLeftSibling implements relations.Relations.
+func (x deletionStorage) GetLeftSibling(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) (oid.ID, error) {
+	hdr, err := x.head(ctx, cnrID, objID, false, tokens)
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("failed to read split chain member's header: %w", err)
+	}
+
+	idMember, ok := hdr.PreviousID()
+	if !ok {
+		return oid.ID{}, relations.ErrNoLeftSibling
+	}
+
+	return idMember, nil
+}
+
+// FindSiblingBySplitID implements relations.Relations.
+func (x deletionStorage) FindSiblingBySplitID(ctx context.Context, cnrID cid.ID, splitID *object.SplitID, tokens relations.Tokens) ([]oid.ID, error) {
+	var query object.SearchFilters
+	query.AddSplitIDFilter(object.MatchStringEqual, splitID)
+
+	res, err := x.search(ctx, cnrID, query, tokens)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search objects by split ID: %w", err)
+	}
+
+	return res, nil
+}
+
+// FindSiblingByParentID implements relations.Relations.
+func (x deletionStorage) FindSiblingByParentID(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) ([]oid.ID, error) {
+	var query object.SearchFilters
+	query.AddParentIDFilter(object.MatchStringEqual, objID)
+
+	res, err := x.search(ctx, cnrID, query, tokens)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find object children: %w", err)
+	}
+
+	return res, nil
+}
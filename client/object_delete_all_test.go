@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/deletion"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ObjectDeleteAll(t *testing.T) {
+	s, c := newObjectServer(t)
+	ctx := context.Background()
+
+	cnr := cidtest.ID()
+
+	var (
+		first   = s.storeRegular(t, cnr)
+		second  = s.storeRegular(t, cnr)
+		locked  = s.storeRegular(t, cnr)
+		missing = oidtest.ID()
+	)
+
+	var tombstones []object.Object
+
+	s.putHook = func(obj object.Object) apistatus.Status {
+		var ts object.Tombstone
+		require.NoError(t, ts.Unmarshal(obj.Payload()))
+
+		for _, m := range ts.Members() {
+			if m.Equals(locked) {
+				return new(apistatus.ObjectLocked)
+			}
+		}
+
+		tombstones = append(tombstones, obj)
+
+		return nil
+	}
+
+	var prm PrmObjectDeleteAll
+
+	require.Panics(t, func() { _, _ = c.ObjectDeleteAll(ctx, prm) })
+
+	prm.FromContainer(cnr)
+
+	require.Panics(t, func() { _, _ = c.ObjectDeleteAll(ctx, prm) })
+
+	ids := []oid.ID{first, second, locked, missing}
+	prm.ByIDs(ids...)
+
+	res, err := c.ObjectDeleteAll(ctx, prm)
+	require.NoError(t, err)
+
+	results := res.Results()
+	require.Len(t, results, len(ids))
+
+	for i, st := range []deletion.Status{
+		deletion.StatusRemoved,
+		deletion.StatusRemoved,
+		deletion.StatusLocked,
+		deletion.StatusNotFound,
+	} {
+		require.Equal(t, ids[i], results[i].Object(), i)
+		require.Equal(t, st, results[i].Status(), i)
+	}
+
+	// batch is rejected because of the locked object, then objects are
+	// retried separately
+	require.Len(t, tombstones, 2)
+
+	for i, obj := range tombstones {
+		require.Equal(t, object.TypeTombstone, obj.Type())
+
+		var ts object.Tombstone
+		require.NoError(t, ts.Unmarshal(obj.Payload()))
+		require.Equal(t, []oid.ID{ids[i]}, ts.Members())
+		require.EqualValues(t, 10+deletion.DefaultTombstoneLifetime, ts.ExpirationEpoch())
+
+		exp := strconv.FormatUint(ts.ExpirationEpoch(), 10)
+		require.Contains(t, obj.Attributes(), expirationAttribute(exp))
+
+		id, _ := obj.ID()
+		tsID, ok := results[i].Tombstone()
+		require.True(t, ok)
+		require.Equal(t, id, tsID)
+	}
+
+	tombstones = nil
+
+	prm.ByIDs(first, second)
+	prm.SetTombstoneExpirationEpoch(42)
+
+	res, err = c.ObjectDeleteAll(ctx, prm)
+	require.NoError(t, err)
+	require.Len(t, tombstones, 1)
+
+	var ts object.Tombstone
+	require.NoError(t, ts.Unmarshal(tombstones[0].Payload()))
+	require.Equal(t, []oid.ID{first, second}, ts.Members())
+	require.EqualValues(t, 42, ts.ExpirationEpoch())
+
+	for _, r := range res.Results() {
+		require.Equal(t, deletion.StatusRemoved, r.Status())
+	}
+}
+
+func expirationAttribute(val string) object.Attribute {
+	var a object.Attribute
+	a.SetKey(object.AttributeExpirationEpoch)
+	a.SetValue(val)
+
+	return a
+}
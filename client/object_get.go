@@ -107,6 +107,8 @@ type ResObjectGet struct {
 type ObjectReader struct {
 	cancelCtxStream context.CancelFunc
 
+	call streamCall
+
 	client *Client
 	stream interface {
 		Read(resp *v2object.GetResponse) error
@@ -135,6 +137,8 @@ func (x *ObjectReader) ReadHeader(dst *object.Object) bool {
 		return false
 	}
 
+	x.call.recv(&resp)
+
 	x.res.st, x.err = x.client.processResponse(&resp)
 	if x.err != nil || !apistatus.IsSuccessful(x.res.st) {
 		return false
@@ -188,6 +192,8 @@ func (x *ObjectReader) readChunk(buf []byte) (int, bool) {
 			return read, false
 		}
 
+		x.call.recv(&resp)
+
 		x.res.st, x.err = x.client.processResponse(&resp)
 		if x.err != nil || !apistatus.IsSuccessful(x.res.st) {
 			return read, false
@@ -228,8 +234,9 @@ func (x *ObjectReader) ReadChunk(buf []byte) (int, bool) {
 	return x.readChunk(buf)
 }
 
-func (x *ObjectReader) close(ignoreEOF bool) (*ResObjectGet, error) {
+func (x *ObjectReader) close(ignoreEOF bool) (res *ResObjectGet, err error) {
 	defer x.cancelCtxStream()
+	defer func() { x.call.end(x.res.st, err) }()
 
 	if x.err != nil {
 		if !errors.Is(x.err, io.EOF) {
@@ -326,22 +333,34 @@ func (c *Client) ObjectGetInit(ctx context.Context, prm PrmObjectGet) (*ObjectRe
 		key = &c.prm.key
 	}
 
-	err := signature.SignServiceMessage(key, &req)
-	if err != nil {
-		return nil, fmt.Errorf("sign request: %w", err)
-	}
+	var r ObjectReader
 
 	ctx, cancel := context.WithCancel(ctx)
 
-	stream, err := rpcapi.GetObject(&c.c, &req, client.WithContext(ctx))
+	call, err := interceptStream(ctx, c.prm.streamInterceptors, MethodObjectGet, req.GetMetaHeader(), func(ctx context.Context) error {
+		req.SetVerificationHeader(nil)
+
+		err := signature.SignServiceMessage(key, &req)
+		if err != nil {
+			return fmt.Errorf("sign request: %w", err)
+		}
+
+		r.stream, err = rpcapi.GetObject(&c.c, &req, client.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("open stream: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
 		cancel()
-		return nil, fmt.Errorf("open stream: %w", err)
+		return nil, err
 	}
 
-	var r ObjectReader
+	call.send(&req)
+
+	r.call = call
 	r.cancelCtxStream = cancel
-	r.stream = stream
 	r.client = c
 
 	return &r, nil
@@ -437,19 +456,28 @@ func (c *Client) ObjectHead(ctx context.Context, prm PrmObjectHead) (*ResObjectH
 		key = prm.key
 	}
 
-	// sign the request
-	err := signature.SignServiceMessage(&key, &req)
-	if err != nil {
-		return nil, fmt.Errorf("sign request: %w", err)
-	}
+	var (
+		res  ResObjectHead
+		resp *v2object.HeadResponse
+	)
 
-	resp, err := rpcapi.HeadObject(&c.c, &req, client.WithContext(ctx))
-	if err != nil {
-		return nil, fmt.Errorf("write request: %w", err)
-	}
+	err := interceptUnary(ctx, c.prm.unaryInterceptors, MethodObjectHead, &req, func(ctx context.Context) (responseV2, apistatus.Status, error) {
+		req.SetVerificationHeader(nil)
 
-	var res ResObjectHead
-	res.st, err = c.processResponse(resp)
+		// sign the request
+		err := signature.SignServiceMessage(&key, &req)
+		if err != nil {
+			return nil, nil, fmt.Errorf("sign request: %w", err)
+		}
+
+		resp, err = rpcapi.HeadObject(&c.c, &req, client.WithContext(ctx))
+		if err != nil {
+			return nil, nil, fmt.Errorf("write request: %w", err)
+		}
+
+		res.st, err = c.processResponse(resp)
+		return resp, res.st, err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -512,6 +540,8 @@ type ResObjectRange struct {
 type ObjectRangeReader struct {
 	cancelCtxStream context.CancelFunc
 
+	call streamCall
+
 	client *Client
 
 	res ResObjectRange
@@ -549,6 +579,8 @@ func (x *ObjectRangeReader) readChunk(buf []byte) (int, bool) {
 			return read, false
 		}
 
+		x.call.recv(&resp)
+
 		x.res.st, x.err = x.client.processResponse(&resp)
 		if x.err != nil || !apistatus.IsSuccessful(x.res.st) {
 			return read, false
@@ -593,8 +625,9 @@ func (x *ObjectRangeReader) ReadChunk(buf []byte) (int, bool) {
 	return x.readChunk(buf)
 }
 
-func (x *ObjectRangeReader) close(ignoreEOF bool) (*ResObjectRange, error) {
+func (x *ObjectRangeReader) close(ignoreEOF bool) (res *ResObjectRange, err error) {
 	defer x.cancelCtxStream()
+	defer func() { x.call.end(x.res.st, err) }()
 
 	if x.err != nil {
 		if !errors.Is(x.err, io.EOF) {
@@ -696,23 +729,35 @@ func (c *Client) ObjectRangeInit(ctx context.Context, prm PrmObjectRange) (*Obje
 		key = &c.prm.key
 	}
 
-	err := signature.SignServiceMessage(key, &req)
-	if err != nil {
-		return nil, fmt.Errorf("sign request: %w", err)
-	}
+	var r ObjectRangeReader
 
 	ctx, cancel := context.WithCancel(ctx)
 
-	stream, err := rpcapi.GetObjectRange(&c.c, &req, client.WithContext(ctx))
+	call, err := interceptStream(ctx, c.prm.streamInterceptors, MethodObjectRange, req.GetMetaHeader(), func(ctx context.Context) error {
+		req.SetVerificationHeader(nil)
+
+		err := signature.SignServiceMessage(key, &req)
+		if err != nil {
+			return fmt.Errorf("sign request: %w", err)
+		}
+
+		r.stream, err = rpcapi.GetObjectRange(&c.c, &req, client.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("open stream: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
 		cancel()
-		return nil, fmt.Errorf("open stream: %w", err)
+		return nil, err
 	}
 
-	var r ObjectRangeReader
+	call.send(&req)
+
+	r.call = call
 	r.remainingPayloadLen = int(prm.rng.GetLength())
 	r.cancelCtxStream = cancel
-	r.stream = stream
 	r.client = c
 
 	return &r, nil
@@ -192,18 +192,27 @@ func (c *Client) ObjectHash(ctx context.Context, prm PrmObjectHash) (*ResObjectH
 		key = prm.key
 	}
 
-	err := signature.SignServiceMessage(&key, &req)
-	if err != nil {
-		return nil, fmt.Errorf("sign request: %w", err)
-	}
-
-	resp, err := rpcapi.HashObjectRange(&c.c, &req, client.WithContext(ctx))
-	if err != nil {
-		return nil, fmt.Errorf("write request: %w", err)
-	}
-
-	var res ResObjectHash
-	res.st, err = c.processResponse(resp)
+	var (
+		res  ResObjectHash
+		resp *v2object.GetRangeHashResponse
+	)
+
+	err := interceptUnary(ctx, c.prm.unaryInterceptors, MethodObjectHash, &req, func(ctx context.Context) (responseV2, apistatus.Status, error) {
+		req.SetVerificationHeader(nil)
+
+		err := signature.SignServiceMessage(&key, &req)
+		if err != nil {
+			return nil, nil, fmt.Errorf("sign request: %w", err)
+		}
+
+		resp, err = rpcapi.HashObjectRange(&c.c, &req, client.WithContext(ctx))
+		if err != nil {
+			return nil, nil, fmt.Errorf("write request: %w", err)
+		}
+
+		res.st, err = c.processResponse(resp)
+		return resp, res.st, err
+	})
 	if err != nil {
 		return nil, err
 	}
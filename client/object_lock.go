@@ -0,0 +1,639 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+// panic messages of the LOCK operations.
+const (
+	panicMsgMissingLockMembers    = "missing objects to lock"
+	panicMsgMissingLockExpiration = "missing lock expiration"
+)
+
+// shared parameters of the operations forming objects on the client side
+// (LOCK, bulk DELETE).
+type prmObjectCommon struct {
+	key *ecdsa.PrivateKey
+
+	bearer *bearer.Token
+
+	cnrSet bool
+	cnr    cid.ID
+}
+
+// UseKey specifies private key to sign the requests and the formed objects.
+// If key is not provided, then Client default key is used.
+func (x *prmObjectCommon) UseKey(key ecdsa.PrivateKey) {
+	x.key = &key
+}
+
+// WithBearerToken attaches bearer token to be used for the operation.
+//
+// If set, underlying eACL rules will be used in access control.
+//
+// Must be signed.
+func (x *prmObjectCommon) WithBearerToken(t bearer.Token) {
+	x.bearer = &t
+}
+
+// FromContainer specifies NeoFS container of the processed objects.
+// Required parameter.
+func (x *prmObjectCommon) FromContainer(id cid.ID) {
+	x.cnr = id
+	x.cnrSet = true
+}
+
+// LockExpiration describes expiration of the lock set either as an absolute
+// epoch or as a wall-clock time.
+//
+// Instances can be created using built-in var declaration.
+type LockExpiration struct {
+	set bool
+
+	epoch uint64
+
+	byTime bool
+	time   time.Time
+}
+
+// SetExpirationEpoch sets the last NeoFS epoch of the lock. Objects remain
+// locked until the end of the epoch.
+//
+// Either SetExpirationEpoch or SetExpirationTime must be called.
+func (x *LockExpiration) SetExpirationEpoch(epoch uint64) {
+	x.set = true
+	x.byTime = false
+	x.epoch = epoch
+}
+
+// SetExpirationTime sets the moment of time objects must remain locked until.
+// The time is converted to the earliest epoch which guarantees it according
+// to the current network settings (see netmap.NetworkInfo.EpochCovering).
+//
+// Either SetExpirationEpoch or SetExpirationTime must be called.
+func (x *LockExpiration) SetExpirationTime(t time.Time) {
+	x.set = true
+	x.byTime = true
+	x.time = t
+}
+
+// Epoch returns the last epoch of the lock. Function reading the current
+// network information is called for the expirations set using
+// SetExpirationTime only.
+//
+// Returns an error if expiration is not set or can't be converted into epoch.
+func (x LockExpiration) Epoch(networkInfo func() (netmap.NetworkInfo, error)) (uint64, error) {
+	if !x.set {
+		return 0, errors.New(panicMsgMissingLockExpiration)
+	}
+
+	if !x.byTime {
+		return x.epoch, nil
+	}
+
+	ni, err := networkInfo()
+	if err != nil {
+		return 0, err
+	}
+
+	epoch, ok := ni.EpochCovering(time.Until(x.time))
+	if !ok {
+		return 0, errors.New("missing epoch duration parameters in network info")
+	}
+
+	return epoch, nil
+}
+
+// PrmObjectLock groups parameters of ObjectLock operation.
+type PrmObjectLock struct {
+	prmObjectCommon
+	LockExpiration
+
+	session *session.Object
+
+	members []oid.ID
+}
+
+// WithinSession specifies session within which LOCK object should be stored.
+//
+// Must be signed.
+func (x *PrmObjectLock) WithinSession(t session.Object) {
+	x.session = &t
+}
+
+// LockObjects specifies identifiers of the objects to be locked. All objects
+// MUST be regular and belong to the container set using FromContainer.
+// Required parameter.
+//
+// Slice must not be mutated until the operation completes.
+func (x *PrmObjectLock) LockObjects(ids ...oid.ID) {
+	x.members = ids
+}
+
+// ResObjectLock groups resulting values of ObjectLock and ObjectExtendLock
+// operations.
+type ResObjectLock struct {
+	id oid.ID
+
+	exp uint64
+}
+
+// LockID returns identifier of the stored LOCK object.
+func (x ResObjectLock) LockID() oid.ID {
+	return x.id
+}
+
+// ExpirationEpoch returns the last epoch of the lock.
+func (x ResObjectLock) ExpirationEpoch() uint64 {
+	return x.exp
+}
+
+// ObjectLock locks the objects from removal using NeoFS API protocol. The
+// call forms LOCK object with the given members and expiration, signs it and
+// stores in the container.
+//
+// Unlike single-RPC operations, any unsuccessful NeoFS status is returned as
+// an error regardless of PrmInit.ResolveNeoFSFailures. Locking objects of
+// non-regular type results in apistatus.LockNonRegularObject
+// (see IsErrLockNonRegularObject).
+//
+// Immediately panics if parameters are set incorrectly (see PrmObjectLock docs).
+// Context is required and must not be nil. It is used for network communication.
+//
+// Return statuses:
+//   - global (see Client docs);
+//   - *apistatus.ContainerNotFound;
+//   - *apistatus.ObjectAccessDenied;
+//   - *apistatus.LockNonRegularObject;
+//   - *apistatus.SessionTokenNotFound;
+//   - *apistatus.SessionTokenExpired.
+func (c *Client) ObjectLock(ctx context.Context, prm PrmObjectLock) (*ResObjectLock, error) {
+	switch {
+	case ctx == nil:
+		panic(panicMsgMissingContext)
+	case !prm.cnrSet:
+		panic(panicMsgMissingContainer)
+	case len(prm.members) == 0:
+		panic(panicMsgMissingLockMembers)
+	case !prm.LockExpiration.set:
+		panic(panicMsgMissingLockExpiration)
+	}
+
+	exp, err := c.lockExpirationEpoch(ctx, prm.LockExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.putLock(ctx, prm.prmObjectCommon, prm.session, prm.members, exp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResObjectLock{
+		id:  id,
+		exp: exp,
+	}, nil
+}
+
+// PrmObjectExtendLock groups parameters of ObjectExtendLock operation.
+type PrmObjectExtendLock struct {
+	prmObjectCommon
+	LockExpiration
+
+	session *session.Object
+
+	lockSet bool
+	lock    oid.ID
+}
+
+// WithinSession specifies session within which new LOCK object should be
+// stored.
+//
+// Must be signed.
+func (x *PrmObjectExtendLock) WithinSession(t session.Object) {
+	x.session = &t
+}
+
+// ByID specifies identifier of the LOCK object to be extended.
+// Required parameter.
+func (x *PrmObjectExtendLock) ByID(id oid.ID) {
+	x.lock = id
+	x.lockSet = true
+}
+
+// ObjectExtendLock extends the lock with the given identifier. Since LOCK
+// objects are immutable, the call reads members of the referenced lock and
+// stores new LOCK object with the same members and later expiration. The
+// original lock remains valid until its own expiration.
+//
+// Returns an error if new expiration epoch is not after the current one of
+// the referenced lock.
+//
+// Any unsuccessful NeoFS status is returned as an error regardless of
+// PrmInit.ResolveNeoFSFailures.
+//
+// Immediately panics if parameters are set incorrectly (see PrmObjectExtendLock docs).
+// Context is required and must not be nil. It is used for network communication.
+//
+// Return statuses:
+//   - global (see Client docs);
+//   - *apistatus.ContainerNotFound;
+//   - *apistatus.ObjectNotFound;
+//   - *apistatus.ObjectAccessDenied;
+//   - *apistatus.ObjectAlreadyRemoved;
+//   - *apistatus.LockNonRegularObject.
+func (c *Client) ObjectExtendLock(ctx context.Context, prm PrmObjectExtendLock) (*ResObjectLock, error) {
+	switch {
+	case ctx == nil:
+		panic(panicMsgMissingContext)
+	case !prm.cnrSet:
+		panic(panicMsgMissingContainer)
+	case !prm.lockSet:
+		panic(panicMsgMissingObject)
+	case !prm.LockExpiration.set:
+		panic(panicMsgMissingLockExpiration)
+	}
+
+	info, err := c.readLock(ctx, prm.prmObjectCommon, prm.lock)
+	if err != nil {
+		return nil, fmt.Errorf("read lock %s: %w", prm.lock, err)
+	}
+
+	exp, err := c.lockExpirationEpoch(ctx, prm.LockExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	if exp <= info.exp {
+		return nil, fmt.Errorf("new expiration epoch %d is not after the current one %d", exp, info.exp)
+	}
+
+	id, err := c.putLock(ctx, prm.prmObjectCommon, prm.session, info.members, exp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResObjectLock{
+		id:  id,
+		exp: exp,
+	}, nil
+}
+
+// ObjectLockInfo groups information about LOCK object.
+type ObjectLockInfo struct {
+	id oid.ID
+
+	exp uint64
+
+	members []oid.ID
+}
+
+// ID returns identifier of the LOCK object.
+func (x ObjectLockInfo) ID() oid.ID {
+	return x.id
+}
+
+// ExpirationEpoch returns the last epoch of the lock. Zero means lock
+// without expiration.
+func (x ObjectLockInfo) ExpirationEpoch() uint64 {
+	return x.exp
+}
+
+// Members returns identifiers of the locked objects.
+//
+// Result must not be mutated.
+func (x ObjectLockInfo) Members() []oid.ID {
+	return x.members
+}
+
+// PrmObjectListLocks groups parameters of ObjectListLocks operation.
+type PrmObjectListLocks struct {
+	prmObjectCommon
+
+	objSet bool
+	obj    oid.ID
+}
+
+// ByID specifies identifier of the object to list locks for.
+// Required parameter.
+func (x *PrmObjectListLocks) ByID(id oid.ID) {
+	x.obj = id
+	x.objSet = true
+}
+
+// ResObjectListLocks groups resulting values of ObjectListLocks operation.
+type ResObjectListLocks struct {
+	locks []ObjectLockInfo
+}
+
+// Locks returns information about all unexpired locks covering the object.
+func (x ResObjectListLocks) Locks() []ObjectLockInfo {
+	return x.locks
+}
+
+// ObjectListLocks lists all locks of the object which have not expired yet.
+// The call searches for all LOCK objects in the container and reads each of
+// them, so it is heavy for the containers with lots of locks.
+//
+// Any unsuccessful NeoFS status is returned as an error regardless of
+// PrmInit.ResolveNeoFSFailures.
+//
+// Immediately panics if parameters are set incorrectly (see PrmObjectListLocks docs).
+// Context is required and must not be nil. It is used for network communication.
+//
+// Return statuses:
+//   - global (see Client docs);
+//   - *apistatus.ContainerNotFound;
+//   - *apistatus.ObjectAccessDenied.
+func (c *Client) ObjectListLocks(ctx context.Context, prm PrmObjectListLocks) (*ResObjectListLocks, error) {
+	switch {
+	case ctx == nil:
+		panic(panicMsgMissingContext)
+	case !prm.cnrSet:
+		panic(panicMsgMissingContainer)
+	case !prm.objSet:
+		panic(panicMsgMissingObject)
+	}
+
+	ni, err := c.networkInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filters object.SearchFilters
+	filters.AddTypeFilter(object.MatchStringEqual, object.TypeLock)
+
+	var prmSearch PrmObjectSearch
+	prmSearch.InContainer(prm.cnr)
+	prmSearch.SetFilters(filters)
+	prmSearch.key = prm.key
+
+	if prm.bearer != nil {
+		prmSearch.WithBearerToken(*prm.bearer)
+	}
+
+	r, err := c.ObjectSearchInit(ctx, prmSearch)
+	if err != nil {
+		return nil, fmt.Errorf("init LOCK objects search: %w", err)
+	}
+
+	var ids []oid.ID
+
+	err = r.Iterate(func(id oid.ID) bool {
+		ids = append(ids, id)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search LOCK objects: %w", err)
+	}
+
+	var res ResObjectListLocks
+
+	for i := range ids {
+		info, err := c.readLock(ctx, prm.prmObjectCommon, ids[i])
+		if err != nil {
+			if IsErrObjectNotFound(err) || IsErrObjectAlreadyRemoved(err) {
+				// lock has been removed after the search
+				continue
+			}
+
+			return nil, fmt.Errorf("read lock %s: %w", ids[i], err)
+		}
+
+		if info.exp != 0 && info.exp < ni.Info().CurrentEpoch() {
+			continue
+		}
+
+		for j := range info.members {
+			if info.members[j].Equals(prm.obj) {
+				res.locks = append(res.locks, info)
+				break
+			}
+		}
+	}
+
+	return &res, nil
+}
+
+// networkInfo requests current network info and resolves failure status
+// into an error.
+func (c *Client) networkInfo(ctx context.Context) (*ResNetworkInfo, error) {
+	res, err := c.NetworkInfo(ctx, PrmNetworkInfo{})
+	if err == nil {
+		err = apistatus.ErrFromStatus(res.Status())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read network info: %w", err)
+	}
+
+	return res, nil
+}
+
+// lockExpirationEpoch returns the last epoch of the lock with the given
+// expiration. Network info is requested for time-based expirations only.
+func (c *Client) lockExpirationEpoch(ctx context.Context, exp LockExpiration) (uint64, error) {
+	return exp.Epoch(func() (netmap.NetworkInfo, error) {
+		res, err := c.networkInfo(ctx)
+		if err != nil {
+			return netmap.NetworkInfo{}, err
+		}
+
+		return res.Info(), nil
+	})
+}
+
+// putLock forms, signs and stores LOCK object with the given members and
+// expiration epoch. Returns identifier of the stored object.
+func (c *Client) putLock(ctx context.Context, prm prmObjectCommon, stoken *session.Object, members []oid.ID, exp uint64) (oid.ID, error) {
+	var lock object.Lock
+	lock.WriteMembers(members)
+
+	id, err := c.putSystemObject(ctx, prm, stoken, object.TypeLock, lock.Marshal(), exp)
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("write LOCK object: %w", err)
+	}
+
+	return id, nil
+}
+
+// putSystemObject forms, signs and stores object of the given type with the
+// given payload and expiration epoch. Returns identifier of the stored object.
+func (c *Client) putSystemObject(ctx context.Context, prm prmObjectCommon, stoken *session.Object, typ object.Type, payload []byte, exp uint64) (oid.ID, error) {
+	key := c.prm.key
+	if prm.key != nil {
+		key = *prm.key
+	}
+
+	var owner user.ID
+	user.IDFromKey(&owner, key.PublicKey)
+
+	var obj object.Object
+	object.InitCreation(&obj, object.RequiredFields{
+		Container: prm.cnr,
+		Owner:     owner,
+	})
+
+	ver := version.Current()
+	obj.SetVersion(&ver)
+	obj.SetType(typ)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+
+	var a object.Attribute
+	a.SetKey(object.AttributeExpirationEpoch)
+	a.SetValue(strconv.FormatUint(exp, 10))
+
+	obj.SetAttributes(a)
+
+	if stoken != nil {
+		obj.SetSessionToken(stoken)
+	}
+
+	err := object.SetVerificationFields(key, &obj)
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("finalize object: %w", err)
+	}
+
+	var prmPut PrmObjectPutInit
+	prmPut.UseKey(key)
+
+	if prm.bearer != nil {
+		prmPut.WithBearerToken(*prm.bearer)
+	}
+
+	if stoken != nil {
+		prmPut.WithinSession(*stoken)
+	}
+
+	w, err := c.ObjectPutInit(ctx, prmPut)
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("init object writing: %w", err)
+	}
+
+	if w.WriteHeader(obj) {
+		w.WritePayloadChunk(payload)
+	}
+
+	res, err := w.Close()
+	if err == nil {
+		err = apistatus.ErrFromStatus(res.Status())
+	}
+
+	if err != nil {
+		return oid.ID{}, err
+	}
+
+	return res.StoredObjectID(), nil
+}
+
+// readLock reads LOCK object by its identifier and decodes its payload.
+func (c *Client) readLock(ctx context.Context, prm prmObjectCommon, id oid.ID) (ObjectLockInfo, error) {
+	var prmGet PrmObjectGet
+	prmGet.FromContainer(prm.cnr)
+	prmGet.ByID(id)
+	prmGet.key = prm.key
+
+	if prm.bearer != nil {
+		prmGet.WithBearerToken(*prm.bearer)
+	}
+
+	r, err := c.ObjectGetInit(ctx, prmGet)
+	if err != nil {
+		return ObjectLockInfo{}, fmt.Errorf("init object reading: %w", err)
+	}
+
+	var obj object.Object
+
+	if !r.ReadHeader(&obj) {
+		res, err := r.Close()
+		if err == nil {
+			err = apistatus.ErrFromStatus(res.Status())
+		}
+
+		if err == nil {
+			err = errors.New("missing object header")
+		}
+
+		return ObjectLockInfo{}, fmt.Errorf("read header: %w", err)
+	}
+
+	payload, err := io.ReadAll(r)
+	if err != nil {
+		return ObjectLockInfo{}, fmt.Errorf("read payload: %w", err)
+	}
+
+	res, err := r.Close()
+	if err == nil {
+		err = apistatus.ErrFromStatus(res.Status())
+	}
+
+	if err != nil {
+		return ObjectLockInfo{}, fmt.Errorf("finish object reading: %w", err)
+	}
+
+	obj.SetPayload(payload)
+
+	var info ObjectLockInfo
+
+	err = ReadLockInfo(&info, id, obj)
+	if err != nil {
+		return ObjectLockInfo{}, err
+	}
+
+	return info, nil
+}
+
+// ReadLockInfo reads information about LOCK object with the given identifier
+// from the object with the payload. Returns an error if the object is not
+// a correct LOCK object.
+func ReadLockInfo(dst *ObjectLockInfo, id oid.ID, obj object.Object) error {
+	if obj.Type() != object.TypeLock {
+		return fmt.Errorf("unexpected object type %s", obj.Type())
+	}
+
+	var lock object.Lock
+
+	err := object.ReadLock(&lock, obj)
+	if err != nil {
+		return fmt.Errorf("decode lock: %w", err)
+	}
+
+	res := ObjectLockInfo{
+		id:      id,
+		members: make([]oid.ID, lock.NumberOfMembers()),
+	}
+
+	lock.ReadMembers(res.members)
+
+	for _, a := range obj.Attributes() {
+		if a.Key() == object.AttributeExpirationEpoch {
+			res.exp, err = strconv.ParseUint(a.Value(), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid expiration attribute: %w", err)
+			}
+
+			break
+		}
+	}
+
+	*dst = res
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package client
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	sessiontest "github.com/nspcc-dev/neofs-sdk-go/session/test"
+	"github.com/stretchr/testify/require"
+)
+
+// storedLock returns information about stored LOCK object.
+func storedLock(t *testing.T, s *objectServer, id oid.ID) ObjectLockInfo {
+	obj, ok := s.object(id)
+	require.True(t, ok)
+
+	var info ObjectLockInfo
+	require.NoError(t, ReadLockInfo(&info, id, obj))
+
+	return info
+}
+
+func TestClient_ObjectLock(t *testing.T) {
+	s, c := newObjectServer(t)
+	ctx := context.Background()
+
+	cnr := cidtest.ID()
+	members := []oid.ID{oidtest.ID(), oidtest.ID()}
+
+	var prm PrmObjectLock
+
+	require.Panics(t, func() { _, _ = c.ObjectLock(ctx, prm) })
+
+	prm.FromContainer(cnr)
+
+	require.Panics(t, func() { _, _ = c.ObjectLock(ctx, prm) })
+
+	prm.LockObjects(members...)
+
+	require.Panics(t, func() { _, _ = c.ObjectLock(ctx, prm) })
+
+	prm.SetExpirationEpoch(100)
+
+	res, err := c.ObjectLock(ctx, prm)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, res.ExpirationEpoch())
+
+	obj, ok := s.object(res.LockID())
+	require.True(t, ok)
+	require.Equal(t, object.TypeLock, obj.Type())
+
+	objCnr, ok := obj.ContainerID()
+	require.True(t, ok)
+	require.Equal(t, cnr, objCnr)
+
+	info := storedLock(t, s, res.LockID())
+	require.Equal(t, members, info.Members())
+	require.EqualValues(t, 100, info.ExpirationEpoch())
+
+	// epoch 10 lasts 60s, one hour later covers 60 more epochs
+	prm.SetExpirationTime(time.Now().Add(time.Hour))
+
+	res, err = c.ObjectLock(ctx, prm)
+	require.NoError(t, err)
+	require.EqualValues(t, 70, res.ExpirationEpoch())
+
+	s.putHook = func(object.Object) apistatus.Status {
+		return new(apistatus.LockNonRegularObject)
+	}
+
+	_, err = c.ObjectLock(ctx, prm)
+	require.True(t, IsErrLockNonRegularObject(err))
+}
+
+func TestClient_ObjectExtendLock(t *testing.T) {
+	s, c := newObjectServer(t)
+	ctx := context.Background()
+
+	members := []oid.ID{oidtest.ID(), oidtest.ID()}
+
+	var prmLock PrmObjectLock
+	prmLock.FromContainer(cidtest.ID())
+	prmLock.LockObjects(members...)
+	prmLock.SetExpirationEpoch(20)
+
+	resLock, err := c.ObjectLock(ctx, prmLock)
+	require.NoError(t, err)
+
+	var prm PrmObjectExtendLock
+	prm.prmObjectCommon = prmLock.prmObjectCommon
+
+	require.Panics(t, func() { _, _ = c.ObjectExtendLock(ctx, prm) })
+
+	prm.ByID(resLock.LockID())
+
+	require.Panics(t, func() { _, _ = c.ObjectExtendLock(ctx, prm) })
+
+	prm.SetExpirationEpoch(20)
+
+	_, err = c.ObjectExtendLock(ctx, prm)
+	require.Error(t, err)
+
+	prm.SetExpirationEpoch(30)
+
+	res, err := c.ObjectExtendLock(ctx, prm)
+	require.NoError(t, err)
+	require.NotEqual(t, resLock.LockID(), res.LockID())
+	require.EqualValues(t, 30, res.ExpirationEpoch())
+
+	info := storedLock(t, s, res.LockID())
+	require.Equal(t, members, info.Members())
+	require.EqualValues(t, 30, info.ExpirationEpoch())
+
+	st := sessiontest.ObjectSigned()
+	prm.WithinSession(*st)
+
+	res, err = c.ObjectExtendLock(ctx, prm)
+	require.NoError(t, err)
+
+	obj, ok := s.object(res.LockID())
+	require.True(t, ok)
+	require.Equal(t, st, obj.SessionToken())
+
+	// failure status after the payload
+	s.getStatus = new(apistatus.ObjectAccessDenied)
+
+	_, err = c.ObjectExtendLock(ctx, prm)
+	require.ErrorIs(t, err, apistatus.ErrObjectAccessDenied)
+
+	prm.ByID(oidtest.ID())
+	s.getStatus = nil
+
+	_, err = c.ObjectExtendLock(ctx, prm)
+	require.True(t, IsErrObjectNotFound(err))
+}
+
+func TestClient_ObjectListLocks(t *testing.T) {
+	_, c := newObjectServer(t)
+	ctx := context.Background()
+
+	obj := oidtest.ID()
+
+	var prmLock PrmObjectLock
+	prmLock.FromContainer(cidtest.ID())
+
+	lock := func(exp uint64, members ...oid.ID) oid.ID {
+		prmLock.LockObjects(members...)
+		prmLock.SetExpirationEpoch(exp)
+
+		res, err := c.ObjectLock(ctx, prmLock)
+		require.NoError(t, err)
+
+		return res.LockID()
+	}
+
+	exp := []oid.ID{
+		lock(20, obj),
+		lock(10, oidtest.ID(), obj),
+	}
+
+	// expired
+	lock(9, obj)
+	// other object
+	lock(20, oidtest.ID())
+
+	var prm PrmObjectListLocks
+	prm.prmObjectCommon = prmLock.prmObjectCommon
+
+	require.Panics(t, func() { _, _ = c.ObjectListLocks(ctx, prm) })
+
+	prm.ByID(obj)
+
+	res, err := c.ObjectListLocks(ctx, prm)
+	require.NoError(t, err)
+	require.Len(t, res.Locks(), len(exp))
+
+	for _, l := range res.Locks() {
+		require.Contains(t, exp, l.ID())
+		require.Contains(t, l.Members(), obj)
+	}
+}
+
+func TestReadLockInfo(t *testing.T) {
+	var (
+		obj  object.Object
+		info ObjectLockInfo
+	)
+
+	require.Error(t, ReadLockInfo(&info, oidtest.ID(), obj))
+
+	var lock object.Lock
+	lock.WriteMembers([]oid.ID{oidtest.ID()})
+	object.WriteLock(&obj, lock)
+
+	var a object.Attribute
+	a.SetKey(object.AttributeExpirationEpoch)
+	a.SetValue("not a number")
+	obj.SetAttributes(a)
+
+	require.Error(t, ReadLockInfo(&info, oidtest.ID(), obj))
+
+	a.SetValue(strconv.FormatUint(42, 10))
+	obj.SetAttributes(a)
+
+	id := oidtest.ID()
+
+	require.NoError(t, ReadLockInfo(&info, id, obj))
+	require.Equal(t, id, info.ID())
+	require.EqualValues(t, 42, info.ExpirationEpoch())
+	require.Len(t, info.Members(), 1)
+}
@@ -0,0 +1,376 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	v2netmap "github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	netmapgrpc "github.com/nspcc-dev/neofs-api-go/v2/netmap/grpc"
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectgrpc "github.com/nspcc-dev/neofs-api-go/v2/object/grpc"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	v2session "github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+/*
+File contains in-memory NeoFS object storage served over gRPC which is used
+to test operations composed of several object RPC.
+*/
+
+// objectServer stores objects of any containers in memory. Only regular
+// and system objects without split hierarchy are supported. Search matches
+// objects by type only.
+type objectServer struct {
+	objectgrpc.UnimplementedObjectServiceServer
+	netmapgrpc.UnimplementedNetmapServiceServer
+
+	mtx sync.Mutex
+
+	ni netmap.NetworkInfo
+
+	objs map[oid.ID]object.Object
+
+	// if set, called before storing each object, non-nil status is returned
+	// instead of storing
+	putHook func(object.Object) apistatus.Status
+
+	// if set, returned by Get after the payload
+	getStatus apistatus.Status
+}
+
+// newObjectServer starts objectServer and returns Client connected to it.
+func newObjectServer(t *testing.T) (*objectServer, *Client) {
+	s := &objectServer{
+		objs: make(map[oid.ID]object.Object),
+	}
+
+	s.ni.SetCurrentEpoch(10)
+	s.ni.SetMagicNumber(1)
+	s.ni.SetMsPerBlock(1000)
+	s.ni.SetEpochDuration(60)
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+
+	objectgrpc.RegisterObjectServiceServer(srv, s)
+	netmapgrpc.RegisterNetmapServiceServer(srv, s)
+
+	go func() { _ = srv.Serve(lis) }()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		srv.Stop()
+	})
+
+	c := newClient(nil)
+	c.c = *client.New(client.WithGRPCConn(conn))
+
+	return s, c
+}
+
+// object returns stored object by its identifier.
+func (x *objectServer) object(id oid.ID) (object.Object, bool) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	obj, ok := x.objs[id]
+
+	return obj, ok
+}
+
+// store saves the object.
+func (x *objectServer) store(obj object.Object) {
+	id, _ := obj.ID()
+
+	x.mtx.Lock()
+	x.objs[id] = obj
+	x.mtx.Unlock()
+}
+
+// storeRegular stores new regular object in the container and returns its
+// identifier.
+func (x *objectServer) storeRegular(t *testing.T, cnr cid.ID) oid.ID {
+	var owner user.ID
+	user.IDFromKey(&owner, key.PublicKey)
+
+	var obj object.Object
+	object.InitCreation(&obj, object.RequiredFields{
+		Container: cnr,
+		Owner:     owner,
+	})
+
+	// make objects unique
+	payload := make([]byte, 8)
+	_, _ = rand.Read(payload)
+
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+
+	require.NoError(t, object.SetVerificationFields(*key, &obj))
+
+	x.store(obj)
+
+	id, _ := obj.ID()
+
+	return id
+}
+
+type signedResponse interface {
+	GetMetaHeader() *v2session.ResponseMetaHeader
+	SetMetaHeader(*v2session.ResponseMetaHeader)
+}
+
+// prepareResponse writes status into the response and signs it.
+func prepareResponse(resp signedResponse, st apistatus.Status) {
+	if st != nil {
+		var meta v2session.ResponseMetaHeader
+		meta.SetStatus(apistatus.ToStatusV2(st))
+
+		resp.SetMetaHeader(&meta)
+	}
+
+	err := signature.SignServiceMessage(key, resp)
+	if err != nil {
+		panic(fmt.Sprintf("sign response: %v", err))
+	}
+}
+
+// NetworkInfo implements netmapgrpc.NetmapServiceServer.
+func (x *objectServer) NetworkInfo(context.Context, *netmapgrpc.NetworkInfoRequest) (*netmapgrpc.NetworkInfoResponse, error) {
+	var ni v2netmap.NetworkInfo
+	x.ni.WriteToV2(&ni)
+
+	var body v2netmap.NetworkInfoResponseBody
+	body.SetNetworkInfo(&ni)
+
+	var resp v2netmap.NetworkInfoResponse
+	resp.SetBody(&body)
+
+	prepareResponse(&resp, nil)
+
+	return resp.ToGRPCMessage().(*netmapgrpc.NetworkInfoResponse), nil
+}
+
+// Put implements objectgrpc.ObjectServiceServer.
+func (x *objectServer) Put(stream objectgrpc.ObjectService_PutServer) error {
+	var (
+		objV2   v2object.Object
+		payload []byte
+	)
+
+	for {
+		m, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return err
+		}
+
+		var req v2object.PutRequest
+
+		err = req.FromGRPCMessage(m)
+		if err != nil {
+			return err
+		}
+
+		switch v := req.GetBody().GetObjectPart().(type) {
+		case *v2object.PutObjectPartInit:
+			objV2.SetObjectID(v.GetObjectID())
+			objV2.SetSignature(v.GetSignature())
+			objV2.SetHeader(v.GetHeader())
+		case *v2object.PutObjectPartChunk:
+			payload = append(payload, v.GetChunk()...)
+		}
+	}
+
+	objV2.SetPayload(payload)
+
+	obj := *object.NewFromV2(&objV2)
+
+	var st apistatus.Status
+	if x.putHook != nil {
+		st = x.putHook(obj)
+	}
+
+	var body v2object.PutResponseBody
+
+	if st == nil {
+		x.store(obj)
+		body.SetObjectID(objV2.GetObjectID())
+	}
+
+	var resp v2object.PutResponse
+	resp.SetBody(&body)
+
+	prepareResponse(&resp, st)
+
+	return stream.SendAndClose(resp.ToGRPCMessage().(*objectgrpc.PutResponse))
+}
+
+// requested reads requested object from the request address.
+func (x *objectServer) requested(addr *refs.Address) (object.Object, apistatus.Status) {
+	var id oid.ID
+
+	err := id.ReadFromV2(*addr.GetObjectID())
+	if err != nil {
+		panic(err)
+	}
+
+	obj, ok := x.object(id)
+	if !ok {
+		return obj, new(apistatus.ObjectNotFound)
+	}
+
+	return obj, nil
+}
+
+// Get implements objectgrpc.ObjectServiceServer.
+func (x *objectServer) Get(m *objectgrpc.GetRequest, stream objectgrpc.ObjectService_GetServer) error {
+	var req v2object.GetRequest
+
+	err := req.FromGRPCMessage(m)
+	if err != nil {
+		return err
+	}
+
+	send := func(part v2object.GetObjectPart, st apistatus.Status) error {
+		var body v2object.GetResponseBody
+		body.SetObjectPart(part)
+
+		var resp v2object.GetResponse
+		resp.SetBody(&body)
+
+		prepareResponse(&resp, st)
+
+		return stream.Send(resp.ToGRPCMessage().(*objectgrpc.GetResponse))
+	}
+
+	obj, st := x.requested(req.GetBody().GetAddress())
+	if st != nil {
+		return send(nil, st)
+	}
+
+	objV2 := obj.ToV2()
+
+	var init v2object.GetObjectPartInit
+	init.SetObjectID(objV2.GetObjectID())
+	init.SetSignature(objV2.GetSignature())
+	init.SetHeader(objV2.GetHeader())
+
+	err = send(&init, nil)
+	if err != nil {
+		return err
+	}
+
+	var chunk v2object.GetObjectPartChunk
+	chunk.SetChunk(objV2.GetPayload())
+
+	err = send(&chunk, nil)
+	if err != nil || x.getStatus == nil {
+		return err
+	}
+
+	return send(nil, x.getStatus)
+}
+
+// Head implements objectgrpc.ObjectServiceServer.
+func (x *objectServer) Head(_ context.Context, m *objectgrpc.HeadRequest) (*objectgrpc.HeadResponse, error) {
+	var req v2object.HeadRequest
+
+	err := req.FromGRPCMessage(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var body v2object.HeadResponseBody
+
+	obj, st := x.requested(req.GetBody().GetAddress())
+	if st == nil {
+		objV2 := obj.ToV2()
+
+		var hdr v2object.HeaderWithSignature
+		hdr.SetHeader(objV2.GetHeader())
+		hdr.SetSignature(objV2.GetSignature())
+
+		body.SetHeaderPart(&hdr)
+	}
+
+	var resp v2object.HeadResponse
+	resp.SetBody(&body)
+
+	prepareResponse(&resp, st)
+
+	return resp.ToGRPCMessage().(*objectgrpc.HeadResponse), nil
+}
+
+// Search implements objectgrpc.ObjectServiceServer.
+func (x *objectServer) Search(m *objectgrpc.SearchRequest, stream objectgrpc.ObjectService_SearchServer) error {
+	var req v2object.SearchRequest
+
+	err := req.FromGRPCMessage(m)
+	if err != nil {
+		return err
+	}
+
+	var typ object.Type
+	typed := false
+
+	for _, f := range req.GetBody().GetFilters() {
+		if f.GetKey() != v2object.FilterHeaderObjectType || !typ.FromString(f.GetValue()) {
+			// other filters are not supported
+			typed = false
+			break
+		}
+
+		typed = true
+	}
+
+	var ids []refs.ObjectID
+
+	x.mtx.Lock()
+
+	for _, obj := range x.objs {
+		if typed && obj.Type() == typ {
+			ids = append(ids, *obj.ToV2().GetObjectID())
+		}
+	}
+
+	x.mtx.Unlock()
+
+	var body v2object.SearchResponseBody
+	body.SetIDList(ids)
+
+	var resp v2object.SearchResponse
+	resp.SetBody(&body)
+
+	prepareResponse(&resp, nil)
+
+	return stream.Send(resp.ToGRPCMessage().(*objectgrpc.SearchResponse))
+}
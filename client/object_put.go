@@ -51,6 +51,8 @@ func (x ResObjectPut) StoredObjectID() oid.ID {
 type ObjectWriter struct {
 	cancelCtxStream context.CancelFunc
 
+	call streamCall
+
 	client *Client
 	stream interface {
 		Write(*v2object.PutRequest) error
@@ -124,7 +126,13 @@ func (x *ObjectWriter) WriteHeader(hdr object.Object) bool {
 	}
 
 	x.err = x.stream.Write(&x.req)
-	return x.err == nil
+	if x.err != nil {
+		return false
+	}
+
+	x.call.send(&x.req)
+
+	return true
 }
 
 // WritePayloadChunk writes chunk of the object payload. Result means success.
@@ -170,6 +178,8 @@ func (x *ObjectWriter) WritePayloadChunk(chunk []byte) bool {
 			return false
 		}
 
+		x.call.send(&x.req)
+
 		chunk = chunk[ln:]
 	}
 
@@ -192,8 +202,9 @@ func (x *ObjectWriter) WritePayloadChunk(chunk []byte) bool {
 //   - *apistatus.LockNonRegularObject;
 //   - *apistatus.SessionTokenNotFound;
 //   - *apistatus.SessionTokenExpired.
-func (x *ObjectWriter) Close() (*ResObjectPut, error) {
+func (x *ObjectWriter) Close() (res *ResObjectPut, err error) {
 	defer x.cancelCtxStream()
+	defer func() { x.call.end(x.res.st, err) }()
 
 	// Ignore io.EOF error, because it is expected error for client-side
 	// stream termination by the server. E.g. when stream contains invalid
@@ -206,6 +217,8 @@ func (x *ObjectWriter) Close() (*ResObjectPut, error) {
 		return nil, x.err
 	}
 
+	x.call.recv(&x.respV2)
+
 	x.res.st, x.err = x.client.processResponse(&x.respV2)
 	if x.err != nil {
 		return nil, x.err
@@ -244,23 +257,34 @@ func (c *Client) ObjectPutInit(ctx context.Context, prm PrmObjectPutInit) (*Obje
 
 	var w ObjectWriter
 
+	w.req.SetBody(new(v2object.PutRequestBody))
+	c.prepareRequest(&w.req, &prm.meta)
+
 	ctx, cancel := context.WithCancel(ctx)
-	stream, err := rpcapi.PutObject(&c.c, &w.respV2, client.WithContext(ctx))
+
+	call, err := interceptStream(ctx, c.prm.streamInterceptors, MethodObjectPut, w.req.GetMetaHeader(), func(ctx context.Context) error {
+		var err error
+
+		w.stream, err = rpcapi.PutObject(&c.c, &w.respV2, client.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("open stream: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
 		cancel()
-		return nil, fmt.Errorf("open stream: %w", err)
+		return nil, err
 	}
 
 	w.key = &c.prm.key
 	if prm.key != nil {
 		w.key = prm.key
 	}
+	w.call = call
 	w.cancelCtxStream = cancel
 	w.client = c
-	w.stream = stream
 	w.partInit.SetCopiesNumber(prm.copyNum)
-	w.req.SetBody(new(v2object.PutRequestBody))
-	c.prepareRequest(&w.req, &prm.meta)
 
 	return &w, nil
 }
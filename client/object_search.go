@@ -100,6 +100,7 @@ type ResObjectSearch struct {
 type ObjectListReader struct {
 	client          *Client
 	cancelCtxStream context.CancelFunc
+	call            streamCall
 	err             error
 	res             ResObjectSearch
 	stream          interface {
@@ -133,6 +134,8 @@ func (x *ObjectListReader) Read(buf []oid.ID) (int, bool) {
 			return read, false
 		}
 
+		x.call.recv(&resp)
+
 		x.res.st, x.err = x.client.processResponse(&resp)
 		if x.err != nil || !apistatus.IsSuccessful(x.res.st) {
 			return read, false
@@ -206,9 +209,12 @@ func (x *ObjectListReader) Close() (*ResObjectSearch, error) {
 	defer x.cancelCtxStream()
 
 	if x.err != nil && !errors.Is(x.err, io.EOF) {
+		x.call.end(x.res.st, x.err)
 		return nil, x.err
 	}
 
+	x.call.end(x.res.st, nil)
+
 	return &x.res, nil
 }
 
@@ -247,18 +253,34 @@ func (c *Client) ObjectSearchInit(ctx context.Context, prm PrmObjectSearch) (*Ob
 		key = &c.prm.key
 	}
 
-	err := signature.SignServiceMessage(key, &req)
-	if err != nil {
-		return nil, fmt.Errorf("sign request: %w", err)
-	}
+	var (
+		r   ObjectListReader
+		err error
+	)
 
-	var r ObjectListReader
 	ctx, r.cancelCtxStream = context.WithCancel(ctx)
 
-	r.stream, err = rpcapi.SearchObjects(&c.c, &req, client.WithContext(ctx))
+	r.call, err = interceptStream(ctx, c.prm.streamInterceptors, MethodObjectSearch, req.GetMetaHeader(), func(ctx context.Context) error {
+		req.SetVerificationHeader(nil)
+
+		err := signature.SignServiceMessage(key, &req)
+		if err != nil {
+			return fmt.Errorf("sign request: %w", err)
+		}
+
+		r.stream, err = rpcapi.SearchObjects(&c.c, &req, client.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("open stream: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("open stream: %w", err)
+		r.cancelCtxStream()
+		return nil, err
 	}
+
+	r.call.send(&req)
 	r.client = c
 
 	return &r, nil
@@ -89,12 +89,13 @@ func (c *Client) AnnounceLocalTrust(ctx context.Context, prm PrmAnnounceLocalTru
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.statusRes = &res
-	cc.call = func() (responseV2, error) {
+	cc.method = MethodAnnounceLocalTrust
+	cc.call = func(ctx context.Context) (responseV2, error) {
 		return rpcapi.AnnounceLocalTrust(&c.c, &req, client.WithContext(ctx))
 	}
 
 	// process call
-	if !cc.processCall() {
+	if !cc.processCall(ctx) {
 		return nil, cc.err
 	}
 
@@ -187,12 +188,13 @@ func (c *Client) AnnounceIntermediateTrust(ctx context.Context, prm PrmAnnounceI
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.statusRes = &res
-	cc.call = func() (responseV2, error) {
+	cc.method = MethodAnnounceIntermediate
+	cc.call = func(ctx context.Context) (responseV2, error) {
 		return rpcapi.AnnounceIntermediateResult(&c.c, &req, client.WithContext(ctx))
 	}
 
 	// process call
-	if !cc.processCall() {
+	if !cc.processCall(ctx) {
 		return nil, cc.err
 	}
 
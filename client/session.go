@@ -118,7 +118,8 @@ func (c *Client) SessionCreate(ctx context.Context, prm PrmSessionCreate) (*ResS
 	cc.meta = prm.prmCommonMeta
 	cc.req = &req
 	cc.statusRes = &res
-	cc.call = func() (responseV2, error) {
+	cc.method = MethodSessionCreate
+	cc.call = func(ctx context.Context) (responseV2, error) {
 		return rpcapi.CreateSession(&c.c, &req, client.WithContext(ctx))
 	}
 	cc.result = func(r responseV2) {
@@ -131,7 +132,7 @@ func (c *Client) SessionCreate(ctx context.Context, prm PrmSessionCreate) (*ResS
 	}
 
 	// process call
-	if !cc.processCall() {
+	if !cc.processCall(ctx) {
 		return nil, cc.err
 	}
 
@@ -85,6 +85,12 @@ func (x WrongMagicNumber) ToStatusV2() *status.Status {
 	return &x.v2
 }
 
+// Message returns status message. Zero status returns empty message.
+// Message should be used for debug purposes only.
+func (x WrongMagicNumber) Message() string {
+	return x.v2.Message()
+}
+
 // WriteCorrectMagic writes correct network magic.
 func (x *WrongMagicNumber) WriteCorrectMagic(magic uint64) {
 	// serialize the number
@@ -42,6 +42,12 @@ func (x ContainerNotFound) ToStatusV2() *status.Status {
 	return &x.v2
 }
 
+// Message returns status message. Zero status returns empty message.
+// Message should be used for debug purposes only.
+func (x ContainerNotFound) Message() string {
+	return x.v2.Message()
+}
+
 // EACLNotFound describes status of the failure because of the missing eACL
 // table.
 // Instances provide Status and StatusV2 interfaces.
@@ -79,3 +85,9 @@ func (x EACLNotFound) ToStatusV2() *status.Status {
 	x.v2.SetMessage(defaultEACLNotFoundMsg)
 	return &x.v2
 }
+
+// Message returns status message. Zero status returns empty message.
+// Message should be used for debug purposes only.
+func (x EACLNotFound) Message() string {
+	return x.v2.Message()
+}
@@ -0,0 +1,323 @@
+package apistatus
+
+import (
+	"errors"
+	"strings"
+)
+
+// Sentinel values of the failure statuses. Any failure status (both value and
+// pointer) matches the sentinel of the same type via errors.Is:
+//
+//	if errors.Is(err, apistatus.ErrObjectNotFound) {
+//		// ...
+//	}
+//
+// Use errors.As to access the status details.
+var (
+	ErrServerInternal        ServerInternal
+	ErrWrongMagicNumber      WrongMagicNumber
+	ErrSignatureVerification SignatureVerification
+	ErrNodeUnderMaintenance  NodeUnderMaintenance
+	ErrObjectLocked          ObjectLocked
+	ErrLockNonRegularObject  LockNonRegularObject
+	ErrObjectAccessDenied    ObjectAccessDenied
+	ErrObjectNotFound        ObjectNotFound
+	ErrObjectAlreadyRemoved  ObjectAlreadyRemoved
+	ErrObjectOutOfRange      ObjectOutOfRange
+	ErrContainerNotFound     ContainerNotFound
+	ErrEACLNotFound          EACLNotFound
+	ErrSessionTokenNotFound  SessionTokenNotFound
+	ErrSessionTokenExpired   SessionTokenExpired
+)
+
+// Class is a set of failure status classes. Each status belongs to one or more
+// classes which help to decide how to handle the failure.
+type Class uint8
+
+const (
+	// ClassRetryable is a class of failures which may disappear on retry (e.g.
+	// to another server).
+	ClassRetryable Class = 1 << iota
+
+	// ClassClientFault is a class of failures caused by the incorrect request.
+	ClassClientFault
+
+	// ClassAuth is a class of authentication and authorization failures.
+	ClassAuth
+
+	// ClassNotFound is a class of failures caused by the missing entity.
+	ClassNotFound
+
+	// ClassMaintenance is a class of failures caused by the server maintenance.
+	ClassMaintenance
+)
+
+// Has checks if the set contains all the given classes.
+func (x Class) Has(c Class) bool {
+	return x&c == c && c != 0
+}
+
+// String implements fmt.Stringer.
+func (x Class) String() string {
+	var names []string
+
+	for _, c := range []struct {
+		class Class
+		name  string
+	}{
+		{ClassRetryable, "retryable"},
+		{ClassClientFault, "client-fault"},
+		{ClassAuth, "auth"},
+		{ClassNotFound, "not-found"},
+		{ClassMaintenance, "maintenance"},
+	} {
+		if x&c.class != 0 {
+			names = append(names, c.name)
+		}
+	}
+
+	if len(names) == 0 {
+		return "unclassified"
+	}
+
+	return strings.Join(names, "|")
+}
+
+// classified is implemented by all the failure statuses.
+type classified interface {
+	error
+	class() Class
+}
+
+// Classify returns classes of the failure status in the err chain. Returns
+// zero for errors not caused by NeoFS API statuses (e.g. transport errors) and
+// for unrecognized statuses.
+func Classify(err error) Class {
+	var st classified
+	if errors.As(err, &st) {
+		return st.class()
+	}
+
+	return 0
+}
+
+// IsRetryable checks if err is caused by the status of the ClassRetryable.
+func IsRetryable(err error) bool {
+	return Classify(err).Has(ClassRetryable)
+}
+
+// IsClientFault checks if err is caused by the status of the ClassClientFault.
+func IsClientFault(err error) bool {
+	return Classify(err).Has(ClassClientFault)
+}
+
+// IsAuth checks if err is caused by the status of the ClassAuth.
+func IsAuth(err error) bool {
+	return Classify(err).Has(ClassAuth)
+}
+
+// IsNotFound checks if err is caused by the status of the ClassNotFound.
+func IsNotFound(err error) bool {
+	return Classify(err).Has(ClassNotFound)
+}
+
+// IsMaintenance checks if err is caused by the status of the ClassMaintenance.
+func IsMaintenance(err error) bool {
+	return Classify(err).Has(ClassMaintenance)
+}
+
+// Is implements interface for errors.Is. Matches ErrServerInternal.
+func (x ServerInternal) Is(target error) bool {
+	switch target.(type) {
+	case ServerInternal, *ServerInternal:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x ServerInternal) class() Class {
+	return ClassRetryable
+}
+
+// Is implements interface for errors.Is. Matches ErrWrongMagicNumber.
+func (x WrongMagicNumber) Is(target error) bool {
+	switch target.(type) {
+	case WrongMagicNumber, *WrongMagicNumber:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x WrongMagicNumber) class() Class {
+	return ClassClientFault
+}
+
+// Is implements interface for errors.Is. Matches ErrSignatureVerification.
+func (x SignatureVerification) Is(target error) bool {
+	switch target.(type) {
+	case SignatureVerification, *SignatureVerification:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x SignatureVerification) class() Class {
+	return ClassAuth | ClassClientFault
+}
+
+// Is implements interface for errors.Is. Matches ErrNodeUnderMaintenance.
+func (x NodeUnderMaintenance) Is(target error) bool {
+	switch target.(type) {
+	case NodeUnderMaintenance, *NodeUnderMaintenance:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x NodeUnderMaintenance) class() Class {
+	return ClassMaintenance | ClassRetryable
+}
+
+// Is implements interface for errors.Is. Matches ErrObjectLocked.
+func (x ObjectLocked) Is(target error) bool {
+	switch target.(type) {
+	case ObjectLocked, *ObjectLocked:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x ObjectLocked) class() Class {
+	return ClassClientFault
+}
+
+// Is implements interface for errors.Is. Matches ErrLockNonRegularObject.
+func (x LockNonRegularObject) Is(target error) bool {
+	switch target.(type) {
+	case LockNonRegularObject, *LockNonRegularObject:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x LockNonRegularObject) class() Class {
+	return ClassClientFault
+}
+
+// Is implements interface for errors.Is. Matches ErrObjectAccessDenied.
+func (x ObjectAccessDenied) Is(target error) bool {
+	switch target.(type) {
+	case ObjectAccessDenied, *ObjectAccessDenied:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x ObjectAccessDenied) class() Class {
+	return ClassAuth
+}
+
+// Is implements interface for errors.Is. Matches ErrObjectNotFound.
+func (x ObjectNotFound) Is(target error) bool {
+	switch target.(type) {
+	case ObjectNotFound, *ObjectNotFound:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x ObjectNotFound) class() Class {
+	return ClassNotFound
+}
+
+// Is implements interface for errors.Is. Matches ErrObjectAlreadyRemoved.
+func (x ObjectAlreadyRemoved) Is(target error) bool {
+	switch target.(type) {
+	case ObjectAlreadyRemoved, *ObjectAlreadyRemoved:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x ObjectAlreadyRemoved) class() Class {
+	return ClassNotFound
+}
+
+// Is implements interface for errors.Is. Matches ErrObjectOutOfRange.
+func (x ObjectOutOfRange) Is(target error) bool {
+	switch target.(type) {
+	case ObjectOutOfRange, *ObjectOutOfRange:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x ObjectOutOfRange) class() Class {
+	return ClassClientFault
+}
+
+// Is implements interface for errors.Is. Matches ErrContainerNotFound.
+func (x ContainerNotFound) Is(target error) bool {
+	switch target.(type) {
+	case ContainerNotFound, *ContainerNotFound:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x ContainerNotFound) class() Class {
+	return ClassNotFound
+}
+
+// Is implements interface for errors.Is. Matches ErrEACLNotFound.
+func (x EACLNotFound) Is(target error) bool {
+	switch target.(type) {
+	case EACLNotFound, *EACLNotFound:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x EACLNotFound) class() Class {
+	return ClassNotFound
+}
+
+// Is implements interface for errors.Is. Matches ErrSessionTokenNotFound.
+func (x SessionTokenNotFound) Is(target error) bool {
+	switch target.(type) {
+	case SessionTokenNotFound, *SessionTokenNotFound:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x SessionTokenNotFound) class() Class {
+	return ClassAuth | ClassNotFound | ClassRetryable
+}
+
+// Is implements interface for errors.Is. Matches ErrSessionTokenExpired.
+func (x SessionTokenExpired) Is(target error) bool {
+	switch target.(type) {
+	case SessionTokenExpired, *SessionTokenExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+func (x SessionTokenExpired) class() Class {
+	return ClassAuth | ClassRetryable
+}
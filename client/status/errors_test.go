@@ -0,0 +1,77 @@
+package apistatus_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorsIs(t *testing.T) {
+	for _, tc := range []struct {
+		sentinel error
+		errs     []error
+		class    apistatus.Class
+	}{
+		{apistatus.ErrServerInternal, []error{apistatus.ServerInternal{}, new(apistatus.ServerInternal)}, apistatus.ClassRetryable},
+		{apistatus.ErrWrongMagicNumber, []error{apistatus.WrongMagicNumber{}, new(apistatus.WrongMagicNumber)}, apistatus.ClassClientFault},
+		{apistatus.ErrSignatureVerification, []error{apistatus.SignatureVerification{}, new(apistatus.SignatureVerification)}, apistatus.ClassAuth | apistatus.ClassClientFault},
+		{apistatus.ErrNodeUnderMaintenance, []error{apistatus.NodeUnderMaintenance{}, new(apistatus.NodeUnderMaintenance)}, apistatus.ClassMaintenance | apistatus.ClassRetryable},
+		{apistatus.ErrObjectLocked, []error{apistatus.ObjectLocked{}, new(apistatus.ObjectLocked)}, apistatus.ClassClientFault},
+		{apistatus.ErrLockNonRegularObject, []error{apistatus.LockNonRegularObject{}, new(apistatus.LockNonRegularObject)}, apistatus.ClassClientFault},
+		{apistatus.ErrObjectAccessDenied, []error{apistatus.ObjectAccessDenied{}, new(apistatus.ObjectAccessDenied)}, apistatus.ClassAuth},
+		{apistatus.ErrObjectNotFound, []error{apistatus.ObjectNotFound{}, new(apistatus.ObjectNotFound)}, apistatus.ClassNotFound},
+		{apistatus.ErrObjectAlreadyRemoved, []error{apistatus.ObjectAlreadyRemoved{}, new(apistatus.ObjectAlreadyRemoved)}, apistatus.ClassNotFound},
+		{apistatus.ErrObjectOutOfRange, []error{apistatus.ObjectOutOfRange{}, new(apistatus.ObjectOutOfRange)}, apistatus.ClassClientFault},
+		{apistatus.ErrContainerNotFound, []error{apistatus.ContainerNotFound{}, new(apistatus.ContainerNotFound)}, apistatus.ClassNotFound},
+		{apistatus.ErrEACLNotFound, []error{apistatus.EACLNotFound{}, new(apistatus.EACLNotFound)}, apistatus.ClassNotFound},
+		{apistatus.ErrSessionTokenNotFound, []error{apistatus.SessionTokenNotFound{}, new(apistatus.SessionTokenNotFound)}, apistatus.ClassAuth | apistatus.ClassNotFound | apistatus.ClassRetryable},
+		{apistatus.ErrSessionTokenExpired, []error{apistatus.SessionTokenExpired{}, new(apistatus.SessionTokenExpired)}, apistatus.ClassAuth | apistatus.ClassRetryable},
+	} {
+		for _, err := range tc.errs {
+			wrapped := fmt.Errorf("some context: %w", err)
+
+			require.ErrorIs(t, err, tc.sentinel)
+			require.ErrorIs(t, wrapped, tc.sentinel)
+			require.Equal(t, tc.class, apistatus.Classify(wrapped), tc.class.String())
+		}
+	}
+
+	require.NotErrorIs(t, apistatus.ObjectLocked{}, apistatus.ErrObjectNotFound)
+	require.NotErrorIs(t, errors.New("any error"), apistatus.ErrObjectNotFound)
+
+	require.Zero(t, apistatus.Classify(errors.New("any error")))
+}
+
+func TestClass(t *testing.T) {
+	c := apistatus.ClassAuth | apistatus.ClassRetryable
+
+	require.True(t, c.Has(apistatus.ClassAuth))
+	require.True(t, c.Has(apistatus.ClassAuth|apistatus.ClassRetryable))
+	require.False(t, c.Has(apistatus.ClassAuth|apistatus.ClassNotFound))
+	require.False(t, c.Has(0))
+	require.Equal(t, "retryable|auth", c.String())
+	require.Equal(t, "unclassified", apistatus.Class(0).String())
+
+	err := fmt.Errorf("wrapped: %w", apistatus.SessionTokenExpired{})
+	require.True(t, apistatus.IsAuth(err))
+	require.True(t, apistatus.IsRetryable(err))
+	require.False(t, apistatus.IsNotFound(err))
+	require.False(t, apistatus.IsClientFault(err))
+	require.False(t, apistatus.IsMaintenance(err))
+}
+
+func TestErrorsAs_Details(t *testing.T) {
+	const reason = "any reason"
+
+	var st apistatus.ObjectAccessDenied
+	st.WriteReason(reason)
+
+	err := fmt.Errorf("wrapped: %w", st)
+
+	var res apistatus.ObjectAccessDenied
+	require.ErrorAs(t, err, &res)
+	require.Equal(t, reason, res.Reason())
+}
@@ -42,6 +42,12 @@ func (x ObjectLocked) ToStatusV2() *status.Status {
 	return &x.v2
 }
 
+// Message returns status message. Zero status returns empty message.
+// Message should be used for debug purposes only.
+func (x ObjectLocked) Message() string {
+	return x.v2.Message()
+}
+
 // LockNonRegularObject describes status returned on locking the non-regular object.
 // Instances provide Status and StatusV2 interfaces.
 type LockNonRegularObject struct {
@@ -79,6 +85,12 @@ func (x LockNonRegularObject) ToStatusV2() *status.Status {
 	return &x.v2
 }
 
+// Message returns status message. Zero status returns empty message.
+// Message should be used for debug purposes only.
+func (x LockNonRegularObject) Message() string {
+	return x.v2.Message()
+}
+
 // ObjectAccessDenied describes status of the failure because of the access control violation.
 // Instances provide Status and StatusV2 interfaces.
 type ObjectAccessDenied struct {
@@ -116,6 +128,12 @@ func (x ObjectAccessDenied) ToStatusV2() *status.Status {
 	return &x.v2
 }
 
+// Message returns status message. Zero status returns empty message.
+// Message should be used for debug purposes only.
+func (x ObjectAccessDenied) Message() string {
+	return x.v2.Message()
+}
+
 // WriteReason writes human-readable access rejection reason.
 func (x *ObjectAccessDenied) WriteReason(reason string) {
 	object.WriteAccessDeniedDesc(&x.v2, reason)
@@ -164,6 +182,12 @@ func (x ObjectNotFound) ToStatusV2() *status.Status {
 	return &x.v2
 }
 
+// Message returns status message. Zero status returns empty message.
+// Message should be used for debug purposes only.
+func (x ObjectNotFound) Message() string {
+	return x.v2.Message()
+}
+
 // ObjectAlreadyRemoved describes status of the failure because object has been
 // already removed. Instances provide Status and StatusV2 interfaces.
 type ObjectAlreadyRemoved struct {
@@ -201,6 +225,12 @@ func (x ObjectAlreadyRemoved) ToStatusV2() *status.Status {
 	return &x.v2
 }
 
+// Message returns status message. Zero status returns empty message.
+// Message should be used for debug purposes only.
+func (x ObjectAlreadyRemoved) Message() string {
+	return x.v2.Message()
+}
+
 // ObjectOutOfRange describes status of the failure because of the incorrect
 // provided object ranges.
 // Instances provide Status and StatusV2 interfaces.
@@ -238,3 +268,9 @@ func (x ObjectOutOfRange) ToStatusV2() *status.Status {
 	x.v2.SetMessage(defaultObjectOutOfRangeMsg)
 	return &x.v2
 }
+
+// Message returns status message. Zero status returns empty message.
+// Message should be used for debug purposes only.
+func (x ObjectOutOfRange) Message() string {
+	return x.v2.Message()
+}
@@ -42,6 +42,12 @@ func (x SessionTokenNotFound) ToStatusV2() *status.Status {
 	return &x.v2
 }
 
+// Message returns status message. Zero status returns empty message.
+// Message should be used for debug purposes only.
+func (x SessionTokenNotFound) Message() string {
+	return x.v2.Message()
+}
+
 // SessionTokenExpired describes status of the failure because of the expired session token.
 // Instances provide Status and StatusV2 interfaces.
 type SessionTokenExpired struct {
@@ -78,3 +84,9 @@ func (x SessionTokenExpired) ToStatusV2() *status.Status {
 	x.v2.SetMessage(defaultSessionTokenExpiredMsg)
 	return &x.v2
 }
+
+// Message returns status message. Zero status returns empty message.
+// Message should be used for debug purposes only.
+func (x SessionTokenExpired) Message() string {
+	return x.v2.Message()
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/telemetry"
+)
+
+// exportSpan passes span of the completed call to the exporter.
+func (c *Client) exportSpan(method string, start time.Time, res CallResult, err error) {
+	var code uint32
+	if st := res.Status(); st != nil {
+		code = uint32(apistatus.ToStatusV2(st).Code())
+	}
+
+	c.prm.exporter.ExportSpan(telemetry.Span{
+		Method:           method,
+		Endpoint:         c.endpoint,
+		StatusCode:       code,
+		Start:            start,
+		Duration:         res.Elapsed(),
+		MessagesSent:     res.SentMessages(),
+		MessagesReceived: res.ReceivedMessages(),
+		BytesSent:        res.SentBytes(),
+		BytesReceived:    res.ReceivedBytes(),
+		Err:              err,
+	})
+}
+
+// exportUnary is a UnaryInterceptor which exports spans of unary calls.
+func (c *Client) exportUnary(ctx context.Context, info CallInfo, invoke UnaryInvoker) error {
+	start := time.Now()
+
+	res, err := invoke(ctx)
+	c.exportSpan(info.Method(), start, res, err)
+
+	return err
+}
+
+// exportStream is a StreamInterceptor which exports spans of message streams.
+func (c *Client) exportStream(ctx context.Context, info CallInfo, open StreamOpener) (func(CallResult, error), error) {
+	start := time.Now()
+
+	return func(res CallResult, err error) {
+		c.exportSpan(info.Method(), start, res, err)
+	}, open(ctx)
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/telemetry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Exporter(t *testing.T) {
+	srv := serverNetMap{signResponse: true}
+	exp := telemetry.NewCollector()
+
+	var prm PrmInit
+	prm.SetDefaultPrivateKey(*key)
+	prm.SetExporter(exp)
+
+	var c Client
+	c.Init(prm)
+	c.setNeoFSAPIServer(&srv)
+	c.endpoint = "localhost:8080"
+
+	_, err := c.NetMapSnapshot(context.Background(), PrmNetMapSnapshot{})
+	require.NoError(t, err)
+
+	calls := exp.Calls()
+	require.Len(t, calls, 1)
+	require.Equal(t, MethodNetMapSnapshot, calls[0].Method())
+	require.Equal(t, "localhost:8080", calls[0].Endpoint())
+	require.EqualValues(t, 1, calls[0].Calls())
+	require.Zero(t, calls[0].Errors())
+	require.Positive(t, calls[0].BytesSent())
+	require.Positive(t, calls[0].BytesReceived())
+
+	var spans []telemetry.Span
+
+	c.prm.exporter = spanRecorder(func(s telemetry.Span) { spans = append(spans, s) })
+
+	_, err = c.NetMapSnapshot(context.Background(), PrmNetMapSnapshot{})
+	require.NoError(t, err)
+	require.Len(t, spans, 1)
+	require.EqualValues(t, apistatus.ToStatusV2(statusErr).Code(), spans[0].StatusCode)
+	require.Equal(t, 1, spans[0].MessagesSent)
+	require.Equal(t, 1, spans[0].MessagesReceived)
+}
+
+type spanRecorder func(telemetry.Span)
+
+func (x spanRecorder) ExportSpan(s telemetry.Span) { x(s) }
+
+func (spanRecorder) ObserveHealth(string, bool) {}
+
+func (spanRecorder) ObserveSessionCache(string, bool) {}
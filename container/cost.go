@@ -0,0 +1,255 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/accounting"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+)
+
+// balancePrecision is a precision of the NeoFS balance contract in which all
+// network fees and prices are expressed.
+const balancePrecision = 12
+
+// bytesInGB is a size of the gigabyte used by the network to charge for
+// storage.
+const bytesInGB = 1 << 30
+
+// StorageCostPrm groups parameters of EstimateStorageCost.
+type StorageCostPrm struct {
+	volume uint64
+
+	retention time.Duration
+
+	alphabet uint32
+}
+
+// SetVolume sets expected volume of the container data in bytes (single
+// copy). Required parameter.
+func (x *StorageCostPrm) SetVolume(v uint64) {
+	x.volume = v
+}
+
+// SetRetention sets period during which the data is stored. Period starts at
+// the current epoch, and is converted into the number of epochs using
+// netmap.NetworkInfo.EpochCovering. Required parameter, MUST be positive.
+func (x *StorageCostPrm) SetRetention(d time.Duration) {
+	x.retention = d
+}
+
+// SetAlphabetSize sets number of the Alphabet nodes (Inner Ring nodes which
+// receive the container creation fee). Required parameter.
+func (x *StorageCostPrm) SetAlphabetSize(n uint32) {
+	x.alphabet = n
+}
+
+// NodeStorageCost describes cost of the container storage on the particular
+// node.
+type NodeStorageCost struct {
+	node netmap.NodeInfo
+
+	volume uint64
+
+	epochCost, declaredCost accounting.Decimal
+}
+
+// Node returns descriptor of the storage node.
+func (x NodeStorageCost) Node() netmap.NodeInfo {
+	return x.node
+}
+
+// Volume returns expected volume of the container data in bytes stored on
+// the node.
+func (x NodeStorageCost) Volume() uint64 {
+	return x.volume
+}
+
+// EpochCost returns amount paid to the node for the container storage per
+// epoch according to the network storage price (see
+// netmap.NetworkInfo.StoragePrice).
+func (x NodeStorageCost) EpochCost() accounting.Decimal {
+	return x.epochCost
+}
+
+// DeclaredEpochCost returns amount per epoch according to the price declared
+// by the node (see netmap.NodeInfo.Price). The value is informational:
+// the network charges container owners by the network storage price.
+func (x NodeStorageCost) DeclaredEpochCost() accounting.Decimal {
+	return x.declaredCost
+}
+
+// StorageCost is a result of EstimateStorageCost. All amounts are expressed in
+// the units of the NeoFS balance (precision 12).
+type StorageCost struct {
+	creationFee accounting.Decimal
+
+	epochCost accounting.Decimal
+
+	epochs uint64
+
+	total accounting.Decimal
+
+	nodes []NodeStorageCost
+}
+
+// CreationFee returns fee paid for the container creation to all Alphabet
+// nodes.
+func (x StorageCost) CreationFee() accounting.Decimal {
+	return x.creationFee
+}
+
+// EpochCost returns total amount paid to all container nodes per epoch.
+func (x StorageCost) EpochCost() accounting.Decimal {
+	return x.epochCost
+}
+
+// Epochs returns number of the paid epochs covering the retention period
+// including the current one.
+func (x StorageCost) Epochs() uint64 {
+	return x.epochs
+}
+
+// Total returns total cost of the container: creation fee plus storage cost
+// during all epochs.
+func (x StorageCost) Total() accounting.Decimal {
+	return x.total
+}
+
+// Nodes returns storage cost per container node. Nodes not expected to store
+// any data are omitted.
+func (x StorageCost) Nodes() []NodeStorageCost {
+	return x.nodes
+}
+
+// EstimateStorageCost estimates cost of the container with the given volume
+// of data stored during the retention period in the network described by
+// the network map and network information.
+//
+// Creation fee is a container fee (see netmap.NetworkInfo.ContainerFee) paid
+// to each Alphabet node, named containers (see ReadDomain) additionally cost
+// netmap.NetworkInfo.NamedContainerFee per Alphabet node. Data is distributed
+// over the container nodes according to the container's placement policy
+// (see netmap.NetMap.AnalyzePolicy), and each node is paid the network storage
+// price per stored gigabyte (2^30 bytes) per epoch, rounded down like
+// the network does.
+//
+// Returns an error if any required parameter is missing, the placement policy
+// can't be applied to the network map or the result overflows.
+func EstimateStorageCost(cnr Container, nm netmap.NetMap, ni netmap.NetworkInfo, prm StorageCostPrm) (StorageCost, error) {
+	var res StorageCost
+
+	switch {
+	case prm.volume == 0:
+		return res, errors.New("missing data volume")
+	case prm.retention <= 0:
+		return res, errors.New("missing retention period")
+	case prm.alphabet == 0:
+		return res, errors.New("missing number of Alphabet nodes")
+	}
+
+	last, ok := ni.EpochCovering(prm.retention)
+	if !ok {
+		return res, errors.New("missing epoch duration parameters in network info")
+	}
+
+	res.epochs = last - ni.CurrentEpoch() + 1
+
+	fee := new(big.Int).SetUint64(ni.ContainerFee())
+	if ReadDomain(cnr).Name() != "" {
+		fee.Add(fee, new(big.Int).SetUint64(ni.NamedContainerFee()))
+	}
+
+	fee.Mul(fee, big.NewInt(int64(prm.alphabet)))
+
+	err := setDecimal(&res.creationFee, fee)
+	if err != nil {
+		return res, fmt.Errorf("creation fee: %w", err)
+	}
+
+	var id cid.ID
+	CalculateID(&id, cnr)
+
+	pivot := make([]byte, 32)
+	id.Encode(pivot)
+
+	rep, err := nm.AnalyzePolicy(cnr.PlacementPolicy(), pivot)
+	if err != nil {
+		return res, fmt.Errorf("analyze placement policy: %w", err)
+	}
+
+	shares := rep.StorageShares()
+	if shares == nil {
+		return res, errors.New("placement policy can't be applied to the network map")
+	}
+
+	nodes := nm.Nodes()
+	epochCost := new(big.Int)
+	rate := new(big.Int).SetUint64(ni.StoragePrice())
+
+	for i := range shares {
+		if shares[i] == 0 {
+			continue
+		}
+
+		nc := NodeStorageCost{
+			node:   nodes[i],
+			volume: uint64(math.Round(shares[i] * float64(prm.volume))),
+		}
+
+		cost := storageCost(nc.volume, rate)
+
+		err = setDecimal(&nc.epochCost, cost)
+		if err != nil {
+			return res, fmt.Errorf("epoch cost of node #%d: %w", i, err)
+		}
+
+		err = setDecimal(&nc.declaredCost, storageCost(nc.volume, new(big.Int).SetUint64(nodes[i].Price())))
+		if err != nil {
+			return res, fmt.Errorf("declared epoch cost of node #%d: %w", i, err)
+		}
+
+		epochCost.Add(epochCost, cost)
+		res.nodes = append(res.nodes, nc)
+	}
+
+	err = setDecimal(&res.epochCost, epochCost)
+	if err != nil {
+		return res, fmt.Errorf("epoch cost: %w", err)
+	}
+
+	total := epochCost.Mul(epochCost, new(big.Int).SetUint64(res.epochs))
+	total.Add(total, fee)
+
+	err = setDecimal(&res.total, total)
+	if err != nil {
+		return res, fmt.Errorf("total cost: %w", err)
+	}
+
+	return res, nil
+}
+
+// storageCost returns cost of the volume storage during one epoch for
+// the given price per gigabyte.
+func storageCost(volume uint64, price *big.Int) *big.Int {
+	res := new(big.Int).SetUint64(volume)
+	res.Mul(res, price)
+
+	return res.Quo(res, big.NewInt(bytesInGB))
+}
+
+// setDecimal writes v into d with the NeoFS balance precision.
+func setDecimal(d *accounting.Decimal, v *big.Int) error {
+	if !v.IsInt64() {
+		return fmt.Errorf("value %s overflows int64", v)
+	}
+
+	d.SetValue(v.Int64())
+	d.SetPrecision(balancePrecision)
+
+	return nil
+}
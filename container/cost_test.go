@@ -0,0 +1,119 @@
+package container_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/accounting"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
+	"github.com/stretchr/testify/require"
+)
+
+func newDecimal(v int64) (d accounting.Decimal) {
+	d.SetValue(v)
+	d.SetPrecision(12)
+
+	return
+}
+
+func TestEstimateStorageCost(t *testing.T) {
+	nodes := make([]netmap.NodeInfo, 3)
+	for i := range nodes {
+		nodes[i] = netmaptest.NodeInfo()
+		nodes[i].SetPrice(2)
+		nodes[i].SetCapacity(100)
+	}
+
+	var nm netmap.NetMap
+	nm.SetNodes(nodes)
+
+	var ni netmap.NetworkInfo
+	ni.SetCurrentEpoch(10)
+	ni.SetMsPerBlock(1000)
+	ni.SetEpochDuration(60)
+	ni.SetStoragePrice(5)
+	ni.SetContainerFee(100)
+	ni.SetNamedContainerFee(50)
+
+	var policy netmap.PlacementPolicy
+	require.NoError(t, policy.DecodeString(`REP 3
+CBF 1`))
+
+	var cnr container.Container
+	cnr.Init()
+	cnr.SetPlacementPolicy(policy)
+
+	var prm container.StorageCostPrm
+
+	_, err := container.EstimateStorageCost(cnr, nm, ni, prm)
+	require.Error(t, err)
+
+	prm.SetVolume(2 << 30)
+
+	_, err = container.EstimateStorageCost(cnr, nm, ni, prm)
+	require.Error(t, err)
+
+	// 1.5 epochs
+	prm.SetRetention(90 * time.Second)
+
+	_, err = container.EstimateStorageCost(cnr, nm, ni, prm)
+	require.Error(t, err)
+
+	prm.SetAlphabetSize(7)
+
+	res, err := container.EstimateStorageCost(cnr, nm, ni, prm)
+	require.NoError(t, err)
+	require.Equal(t, newDecimal(700), res.CreationFee())
+	require.EqualValues(t, 3, res.Epochs())
+
+	// each node stores all data
+	require.Len(t, res.Nodes(), 3)
+
+	for _, n := range res.Nodes() {
+		require.EqualValues(t, 2<<30, n.Volume())
+		require.Equal(t, newDecimal(10), n.EpochCost())
+		require.Equal(t, newDecimal(4), n.DeclaredEpochCost())
+	}
+
+	require.Equal(t, newDecimal(30), res.EpochCost())
+	require.Equal(t, newDecimal(700+30*3), res.Total())
+
+	var domain container.Domain
+	domain.SetName("name")
+	container.WriteDomain(&cnr, domain)
+
+	res, err = container.EstimateStorageCost(cnr, nm, ni, prm)
+	require.NoError(t, err)
+	require.Equal(t, newDecimal(1050), res.CreationFee())
+	require.Equal(t, newDecimal(1050+30*3), res.Total())
+
+	// storage cost is rounded down
+	prm.SetVolume(1 << 29)
+
+	res, err = container.EstimateStorageCost(cnr, nm, ni, prm)
+	require.NoError(t, err)
+	require.Equal(t, newDecimal(2), res.Nodes()[0].EpochCost())
+	require.Equal(t, newDecimal(6), res.EpochCost())
+
+	ni.SetStoragePrice(math.MaxUint64)
+
+	_, err = container.EstimateStorageCost(cnr, nm, ni, prm)
+	require.Error(t, err)
+
+	ni.SetStoragePrice(5)
+	ni.SetEpochDuration(0)
+
+	_, err = container.EstimateStorageCost(cnr, nm, ni, prm)
+	require.Error(t, err)
+
+	ni.SetEpochDuration(60)
+
+	require.NoError(t, policy.DecodeString(`REP 4`))
+	cnr.SetPlacementPolicy(policy)
+
+	_, err = container.EstimateStorageCost(cnr, nm, ni, prm)
+	require.Error(t, err)
+}
@@ -22,6 +22,16 @@ it using the instance of Container types
 
 	// process the container data
 
+EstimateStorageCost allows to estimate the container cost before its creation
+
+	var prm StorageCostPrm
+	prm.SetVolume(10 << 30)
+	prm.SetRetention(30 * 24 * time.Hour)
+	prm.SetAlphabetSize(7)
+
+	res, err := EstimateStorageCost(cnr, netMap, networkInfo, prm)
+	// ...
+
 Instances can be also used to process NeoFS API V2 protocol messages
 (see neo.fs.v2.container package in https://github.com/nspcc-dev/neofs-api).
 
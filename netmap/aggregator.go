@@ -5,15 +5,18 @@ import (
 )
 
 type (
-	// aggregator can calculate some value across all netmap
+	// Aggregator can calculate some value across all netmap
 	// such as median, minimum or maximum.
-	aggregator interface {
+	Aggregator interface {
+		// Add adds value to the aggregated set.
 		Add(float64)
+		// Compute returns aggregated value of all added values.
 		Compute() float64
 	}
 
-	// normalizer normalizes weight.
-	normalizer interface {
+	// Normalizer normalizes weight.
+	Normalizer interface {
+		// Normalize returns normalized weight, usually in range of 0.0 to 1.0.
 		Normalize(w float64) float64
 	}
 
@@ -39,55 +42,56 @@ type (
 		scale float64
 	}
 
-	// weightFunc calculates n's weight.
-	weightFunc = func(NodeInfo) float64
+	// WeightFunc calculates node's weight. Nodes with greater weights have
+	// higher priority in HRW placement.
+	WeightFunc = func(NodeInfo) float64
 )
 
 var (
-	_ aggregator = (*meanAgg)(nil)
-	_ aggregator = (*minAgg)(nil)
-	_ aggregator = (*meanIQRAgg)(nil)
+	_ Aggregator = (*meanAgg)(nil)
+	_ Aggregator = (*minAgg)(nil)
+	_ Aggregator = (*meanIQRAgg)(nil)
 
-	_ normalizer = (*reverseMinNorm)(nil)
-	_ normalizer = (*sigmoidNorm)(nil)
+	_ Normalizer = (*reverseMinNorm)(nil)
+	_ Normalizer = (*sigmoidNorm)(nil)
 )
 
-// newWeightFunc returns weightFunc which multiplies normalized
+// NewWeightFunc returns WeightFunc which multiplies normalized
 // capacity and price.
-func newWeightFunc(capNorm, priceNorm normalizer) weightFunc {
+func NewWeightFunc(capNorm, priceNorm Normalizer) WeightFunc {
 	return func(n NodeInfo) float64 {
 		return capNorm.Normalize(float64(n.capacity())) * priceNorm.Normalize(float64(n.Price()))
 	}
 }
 
-// newMeanAgg returns an aggregator which
+// NewMeanAggregator returns an aggregator which
 // computes mean value by recalculating it on
 // every addition.
-func newMeanAgg() aggregator {
+func NewMeanAggregator() Aggregator {
 	return new(meanAgg)
 }
 
-// newMinAgg returns an aggregator which
+// NewMinAggregator returns an aggregator which
 // computes min value.
-func newMinAgg() aggregator {
+func NewMinAggregator() Aggregator {
 	return new(minAgg)
 }
 
-// newMeanIQRAgg returns an aggregator which
+// NewMeanIQRAggregator returns an aggregator which
 // computes mean value of values from IQR interval.
-func newMeanIQRAgg() aggregator {
+func NewMeanIQRAggregator() Aggregator {
 	return new(meanIQRAgg)
 }
 
-// newReverseMinNorm returns a normalizer which
+// NewReverseMinNormalizer returns a normalizer which
 // normalize values in range of 0.0 to 1.0 to a minimum value.
-func newReverseMinNorm(min float64) normalizer {
+func NewReverseMinNormalizer(min float64) Normalizer {
 	return &reverseMinNorm{min: min}
 }
 
-// newSigmoidNorm returns a normalizer which
+// NewSigmoidNormalizer returns a normalizer which
 // normalize values in range of 0.0 to 1.0 to a scaled sigmoid.
-func newSigmoidNorm(scale float64) normalizer {
+func NewSigmoidNormalizer(scale float64) Normalizer {
 	return &sigmoidNorm{scale: scale}
 }
 
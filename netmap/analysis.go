@@ -0,0 +1,346 @@
+package netmap
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+)
+
+// policyAnalysisSamples is a number of the pseudo-random object identifiers
+// used to estimate storage shares of the container nodes.
+const policyAnalysisSamples = 1000
+
+// SelectorReport describes feasibility of the particular Selector from
+// the PlacementPolicy in the NetMap.
+type SelectorReport struct {
+	name string
+
+	err error
+
+	candidates int
+
+	buckets int
+
+	required int
+
+	nodeCut int
+
+	bucketCut int
+}
+
+// Name returns name of the Selector.
+func (x SelectorReport) Name() string {
+	return x.name
+}
+
+// Satisfiable checks if the Selector can be applied to the NetMap.
+//
+// See also Err.
+func (x SelectorReport) Satisfiable() bool {
+	return x.err == nil
+}
+
+// Err returns the reason why the Selector can't be applied to the NetMap.
+// Returns nil if the Selector is satisfiable.
+func (x SelectorReport) Err() error {
+	return x.err
+}
+
+// NumberOfCandidates returns number of the nodes passing the Selector's filter.
+func (x SelectorReport) NumberOfCandidates() int {
+	return x.candidates
+}
+
+// NumberOfBuckets returns number of the candidate buckets: groups of the nodes
+// with the same value of the bucket attribute which are large enough to be
+// selected. Each node is a separate bucket if the bucket attribute is not set.
+func (x SelectorReport) NumberOfBuckets() int {
+	return x.buckets
+}
+
+// NumberOfRequiredBuckets returns number of the buckets the Selector selects.
+func (x SelectorReport) NumberOfRequiredBuckets() int {
+	return x.required
+}
+
+// MinNodeCut returns minimum number of the nodes whose loss makes the Selector
+// unsatisfiable. Returns 0 for unsatisfiable Selector.
+func (x SelectorReport) MinNodeCut() int {
+	return x.nodeCut
+}
+
+// MinBucketCut returns minimum number of the buckets (e.g. countries for the
+// Selector by Country attribute) whose loss makes the Selector unsatisfiable.
+// Returns 0 for unsatisfiable Selector.
+func (x SelectorReport) MinBucketCut() int {
+	return x.bucketCut
+}
+
+// ReplicaReport describes fault tolerance of the particular replica descriptor
+// from the PlacementPolicy in the NetMap.
+type ReplicaReport struct {
+	selector SelectorReport
+
+	count uint32
+}
+
+// NumberOfObjects returns number of object replicas.
+func (x ReplicaReport) NumberOfObjects() uint32 {
+	return x.count
+}
+
+// Selector returns report of the Selector the replica descriptor references.
+// For replica descriptors without a selector, it describes implicit selection
+// of the replica nodes from the whole NetMap if the policy has no selectors,
+// and the weakest selector of the policy otherwise.
+func (x ReplicaReport) Selector() SelectorReport {
+	return x.selector
+}
+
+// MinNodeCut returns minimum number of the nodes whose loss makes the nodes
+// of the replica impossible to select. Returns 0 if the replica nodes can't be
+// selected already.
+func (x ReplicaReport) MinNodeCut() int {
+	return x.selector.nodeCut
+}
+
+// MinBucketCut returns minimum number of the buckets of the Selector whose loss
+// makes the nodes of the replica impossible to select. Returns 0 if the replica
+// nodes can't be selected already.
+func (x ReplicaReport) MinBucketCut() int {
+	return x.selector.bucketCut
+}
+
+// PolicyReport groups results of the PlacementPolicy analysis against the NetMap.
+// Instances are created by NetMap.AnalyzePolicy.
+type PolicyReport struct {
+	selectors []SelectorReport
+
+	replicas []ReplicaReport
+
+	shares []float64
+}
+
+// Satisfiable checks if all selectors of the PlacementPolicy can be applied
+// to the NetMap.
+func (x PolicyReport) Satisfiable() bool {
+	for i := range x.selectors {
+		if !x.selectors[i].Satisfiable() {
+			return false
+		}
+	}
+
+	for i := range x.replicas {
+		if !x.replicas[i].selector.Satisfiable() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Selectors returns reports of the PlacementPolicy selectors in the order
+// they are declared in the policy.
+func (x PolicyReport) Selectors() []SelectorReport {
+	return x.selectors
+}
+
+// Replicas returns reports of the PlacementPolicy replica descriptors in
+// the order they are declared in the policy.
+func (x PolicyReport) Replicas() []ReplicaReport {
+	return x.replicas
+}
+
+// StorageShares returns expected share of the container objects stored on
+// each node: i-th element corresponds to the i-th element of NetMap.Nodes.
+// Shares are estimated by placing a fixed set of pseudo-random objects, so
+// they are deterministic for the fixed NetMap and parameters.
+//
+// Returns nil if the PlacementPolicy is not satisfiable.
+func (x PolicyReport) StorageShares() []float64 {
+	return x.shares
+}
+
+// AnalyzePolicy checks if the PlacementPolicy can be applied to the NetMap
+// for the container identified by the given pivot (see ContainerNodes), and
+// reports the selection feasibility per selector, fault tolerance of the
+// replicas and the expected storage share per node. Returns an error if the
+// policy is invalid regardless of the NetMap (e.g. references unknown filter).
+//
+// Options are applied as in ContainerNodes.
+//
+// Note that fault tolerance is calculated for each selector independently.
+func (m NetMap) AnalyzePolicy(p PlacementPolicy, pivot []byte, opts ...PlacementOption) (PolicyReport, error) {
+	res, err := m.analyzeSelection(p, pivot, opts)
+	if err != nil || !res.Satisfiable() {
+		return res, err
+	}
+
+	shares, err := m.storageShares(p, pivot, opts)
+	if err != nil {
+		// selectors are satisfiable, but placement failed (e.g. policy requires
+		// unique nodes), so it is reported as unsatisfiable replica
+		for i := range res.replicas {
+			res.replicas[i].selector.err = err
+			res.replicas[i].selector.nodeCut = 0
+			res.replicas[i].selector.bucketCut = 0
+		}
+
+		return res, nil
+	}
+
+	res.shares = shares
+
+	return res, nil
+}
+
+// analyzeSelection is AnalyzePolicy without the storage shares estimation.
+// Unlike placement, selection is checked per selector, so the policy which
+// can't be applied as a whole (e.g. requires unique nodes) may be reported
+// as satisfiable.
+func (m NetMap) analyzeSelection(p PlacementPolicy, pivot []byte, opts []PlacementOption) (PolicyReport, error) {
+	var res PolicyReport
+
+	c := newContext(m)
+	c.setPlacementOptions(opts)
+	c.setPivot(pivot)
+	c.setCBF(p.backupFactor)
+
+	if err := c.processFilters(p); err != nil {
+		return res, err
+	}
+
+	res.selectors = make([]SelectorReport, len(p.selectors))
+	reports := make(map[string]SelectorReport, len(p.selectors))
+
+	for i := range p.selectors {
+		fName := p.selectors[i].GetFilter()
+		if _, ok := c.processedFilters[fName]; !ok && fName != mainFilterName {
+			return res, fmt.Errorf("%w: SELECT FROM '%s'", errFilterNotFound, fName)
+		}
+
+		res.selectors[i] = c.analyzeSelector(p, p.selectors[i])
+		reports[p.selectors[i].GetName()] = res.selectors[i]
+	}
+
+	res.replicas = make([]ReplicaReport, len(p.replicas))
+
+	for i := range p.replicas {
+		res.replicas[i].count = p.replicas[i].GetCount()
+
+		sName := p.replicas[i].GetSelector()
+		if sName == "" {
+			if len(p.selectors) == 0 {
+				var s netmap.Selector
+				s.SetCount(p.replicas[i].GetCount())
+				s.SetFilter(mainFilterName)
+
+				res.replicas[i].selector = c.analyzeSelector(p, s)
+
+				continue
+			}
+
+			// replica nodes are selected by all selectors, so the weakest one
+			// limits the replica
+			for j := range res.selectors {
+				if j == 0 || res.selectors[j].nodeCut < res.replicas[i].selector.nodeCut {
+					res.replicas[i].selector = res.selectors[j]
+				}
+			}
+
+			continue
+		}
+
+		sr, ok := reports[sName]
+		if !ok {
+			return res, fmt.Errorf("%w: '%s'", errUnknownSelector, sName)
+		}
+
+		res.replicas[i].selector = sr
+	}
+
+	return res, nil
+}
+
+// analyzeSelector checks feasibility of the Selector and calculates its
+// minimum cuts.
+func (c *context) analyzeSelector(p PlacementPolicy, s netmap.Selector) SelectorReport {
+	res := SelectorReport{
+		name: s.GetName(),
+	}
+
+	bucketCount, nodesInBucket := calcNodesCount(s)
+	res.required = bucketCount
+
+	buckets := c.getSelectionBase(p.subnet, s)
+
+	// cost of the bucket is the number of nodes to lose to make it too small
+	costs := make([]int, 0, len(buckets))
+
+	for i := range buckets {
+		res.candidates += len(buckets[i].nodes)
+
+		if len(buckets[i].nodes) >= nodesInBucket {
+			costs = append(costs, len(buckets[i].nodes)-nodesInBucket+1)
+		}
+	}
+
+	res.buckets = len(costs)
+
+	if _, err := c.getSelection(p, s); err != nil {
+		res.err = err
+		return res
+	}
+
+	res.bucketCut = len(costs) - bucketCount + 1
+
+	sort.Ints(costs)
+
+	for i := 0; i < res.bucketCut; i++ {
+		res.nodeCut += costs[i]
+	}
+
+	return res
+}
+
+// storageShares estimates share of the container objects stored on each node
+// of the NetMap.
+func (m NetMap) storageShares(p PlacementPolicy, pivot []byte, opts []PlacementOption) ([]float64, error) {
+	vectors, err := m.ContainerNodes(p, pivot, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(m.nodes))
+	for i := range m.nodes {
+		index[string(m.nodes[i].PublicKey())] = i
+	}
+
+	counts := make([]int, len(m.nodes))
+
+	var obj [8]byte
+
+	for i := 0; i < policyAnalysisSamples; i++ {
+		binary.BigEndian.PutUint64(obj[:], uint64(i))
+		id := sha256.Sum256(obj[:])
+
+		stored, err := m.objectNodes(p, vectors, id[:], opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for j := range stored {
+			counts[index[string(stored[j].PublicKey())]]++
+		}
+	}
+
+	res := make([]float64, len(counts))
+	for i := range counts {
+		res[i] = float64(counts[i]) / policyAnalysisSamples
+	}
+
+	return res, nil
+}
@@ -0,0 +1,100 @@
+package netmap
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newAnalysisNetMap() (nm NetMap) {
+	// 3 countries with 2 nodes each, last one is in the distinct country
+	nm.SetNodes(nodeInfosFromAttributes(7, func(i int) []string {
+		return []string{"Country", strconv.Itoa(i / 2), "Price", "1", "Capacity", "10"}
+	}))
+
+	return nm
+}
+
+func TestNetMap_AnalyzePolicy(t *testing.T) {
+	nm := newAnalysisNetMap()
+
+	var p PlacementPolicy
+	require.NoError(t, p.DecodeString(`REP 2 IN X
+REP 1 IN Y
+CBF 1
+SELECT 2 IN DISTINCT Country FROM * AS X
+SELECT 1 FROM * AS Y`))
+
+	res, err := nm.AnalyzePolicy(p, []byte{1})
+	require.NoError(t, err)
+	require.True(t, res.Satisfiable())
+
+	require.Len(t, res.Selectors(), 2)
+	s := res.Selectors()[0]
+	require.Equal(t, "X", s.Name())
+	require.NoError(t, s.Err())
+	require.Equal(t, 7, s.NumberOfCandidates())
+	require.Equal(t, 4, s.NumberOfBuckets())
+	require.Equal(t, 2, s.NumberOfRequiredBuckets())
+	require.Equal(t, 3, s.MinBucketCut())
+	// country with single node and two countries with 2 nodes
+	require.Equal(t, 5, s.MinNodeCut())
+
+	require.Len(t, res.Replicas(), 2)
+	require.EqualValues(t, 2, res.Replicas()[0].NumberOfObjects())
+	require.Equal(t, s, res.Replicas()[0].Selector())
+	require.Equal(t, 3, res.Replicas()[0].MinBucketCut())
+
+	// each node is a bucket
+	require.Equal(t, 7, res.Replicas()[1].MinNodeCut())
+	require.Equal(t, 7, res.Replicas()[1].MinBucketCut())
+
+	shares := res.StorageShares()
+	require.Len(t, shares, 7)
+
+	var sum float64
+	for i := range shares {
+		require.True(t, shares[i] >= 0 && shares[i] <= 1)
+		sum += shares[i]
+	}
+
+	// each object is stored on 2 nodes of X and may be stored on one more node
+	require.True(t, sum >= 2 && sum <= 3, sum)
+}
+
+func TestNetMap_AnalyzePolicyUnsatisfiable(t *testing.T) {
+	nm := newAnalysisNetMap()
+
+	var p PlacementPolicy
+	require.NoError(t, p.DecodeString(`REP 1 IN X
+REP 1 IN Y
+SELECT 5 IN DISTINCT Country FROM * AS X
+SELECT 1 FROM F AS Y
+FILTER Country EQ 0 AS F`))
+
+	res, err := nm.AnalyzePolicy(p, nil)
+	require.NoError(t, err)
+	require.False(t, res.Satisfiable())
+	require.Nil(t, res.StorageShares())
+
+	x := res.Selectors()[0]
+	require.False(t, x.Satisfiable())
+	require.True(t, errors.Is(x.Err(), errNotEnoughNodes))
+	require.Equal(t, 4, x.NumberOfBuckets())
+	require.Zero(t, x.MinNodeCut())
+	require.Zero(t, x.MinBucketCut())
+
+	y := res.Selectors()[1]
+	require.True(t, y.Satisfiable())
+	require.Equal(t, 2, y.NumberOfCandidates())
+	require.Equal(t, 2, y.MinNodeCut())
+
+	p = PlacementPolicy{}
+	require.NoError(t, p.DecodeString(`REP 1 IN X
+SELECT 1 FROM F AS X`))
+
+	_, err = nm.AnalyzePolicy(p, nil)
+	require.ErrorIs(t, err, errFilterNotFound)
+}
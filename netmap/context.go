@@ -31,10 +31,17 @@ type context struct {
 
 	// weightFunc is a weighting function for determining node priority
 	// which combines low price and high performance
-	weightFunc weightFunc
+	weightFunc WeightFunc
+
+	// newBucketAgg constructs aggregator of the node bucket weights
+	newBucketAgg func() Aggregator
 
 	// container backup factor
 	cbf uint32
+
+	// nodes selected for the previous replicas, filled for the policies
+	// requiring unique nodes only
+	usedNodes map[uint64]struct{}
 }
 
 // Various validation errors.
@@ -44,6 +51,7 @@ var (
 	errInvalidFilterOp   = errors.New("invalid filter operation")
 	errFilterNotFound    = errors.New("filter not found")
 	errNonEmptyFilters   = errors.New("simple filter contains sub-filters")
+	errInvalidNotFilter  = errors.New("NOT filter must contain exactly one sub-filter")
 	errNotEnoughNodes    = errors.New("not enough nodes to SELECT from")
 	errUnnamedTopFilter  = errors.New("unnamed top-level filter")
 )
@@ -56,9 +64,22 @@ func newContext(nm NetMap) *context {
 		processedSelectors: make(map[string]*netmap.Selector),
 		selections:         make(map[string][]nodes),
 
-		numCache:   make(map[string]uint64),
-		weightFunc: defaultWeightFunc(nm.nodes),
+		numCache:     make(map[string]uint64),
+		weightFunc:   defaultWeightFunc(nm.nodes),
+		newBucketAgg: NewMeanIQRAggregator,
+	}
+}
+
+// setPlacementOptions overrides weighting of the nodes and buckets.
+func (c *context) setPlacementOptions(opts []PlacementOption) {
+	if len(opts) == 0 {
+		return
 	}
+
+	o := applyPlacementOptions(opts)
+
+	c.weightFunc = o.weighting.WeightFunc(c.netMap.nodes)
+	c.newBucketAgg = o.newBucketAgg
 }
 
 func (c *context) setPivot(pivot []byte) {
@@ -68,6 +89,26 @@ func (c *context) setPivot(pivot []byte) {
 	}
 }
 
+// setUnique makes selections to exclude the nodes marked as used.
+func (c *context) setUnique(unique bool) {
+	if unique {
+		c.usedNodes = make(map[uint64]struct{})
+	}
+}
+
+// addUsedNodes marks nodes as used if context requires unique nodes.
+func (c *context) addUsedNodes(ns ...nodes) {
+	if c.usedNodes == nil {
+		return
+	}
+
+	for i := range ns {
+		for j := range ns[i] {
+			c.usedNodes[ns[i][j].Hash()] = struct{}{}
+		}
+	}
+}
+
 func (c *context) setCBF(cbf uint32) {
 	if cbf == 0 {
 		c.cbf = 3
@@ -76,16 +117,16 @@ func (c *context) setCBF(cbf uint32) {
 	}
 }
 
-func defaultWeightFunc(ns nodes) weightFunc {
-	mean := newMeanAgg()
-	min := newMinAgg()
+func defaultWeightFunc(ns nodes) WeightFunc {
+	mean := NewMeanAggregator()
+	min := NewMinAggregator()
 
 	for i := range ns {
 		mean.Add(float64(ns[i].capacity()))
 		min.Add(float64(ns[i].Price()))
 	}
 
-	return newWeightFunc(
-		newSigmoidNorm(mean.Compute()),
-		newReverseMinNorm(min.Compute()))
+	return NewWeightFunc(
+		NewSigmoidNormalizer(mean.Compute()),
+		NewReverseMinNormalizer(min.Compute()))
 }
@@ -0,0 +1,334 @@
+package netmap
+
+import (
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// AttributeChange describes change of the particular node attribute.
+type AttributeChange struct {
+	key, prev, next string
+}
+
+// Key returns key of the attribute.
+func (x AttributeChange) Key() string {
+	return x.key
+}
+
+// Previous returns previous value of the attribute. Empty value means that
+// the attribute has been added.
+func (x AttributeChange) Previous() string {
+	return x.prev
+}
+
+// Next returns new value of the attribute. Empty value means that the
+// attribute has been removed.
+func (x AttributeChange) Next() string {
+	return x.next
+}
+
+// NodeChange describes changes of the node presented in both network maps.
+type NodeChange struct {
+	prev, next NodeInfo
+
+	attrs []AttributeChange
+
+	endpoints bool
+
+	state bool
+}
+
+// PublicKey returns public key of the changed node.
+func (x NodeChange) PublicKey() []byte {
+	return x.next.PublicKey()
+}
+
+// Previous returns node descriptor from the previous network map.
+func (x NodeChange) Previous() NodeInfo {
+	return x.prev
+}
+
+// Next returns node descriptor from the next network map.
+func (x NodeChange) Next() NodeInfo {
+	return x.next
+}
+
+// AttributeChanges returns list of the changed node attributes in the order of
+// the next descriptor, removed attributes go last.
+func (x NodeChange) AttributeChanges() []AttributeChange {
+	return x.attrs
+}
+
+// EndpointsChanged checks if the network endpoints of the node changed.
+func (x NodeChange) EndpointsChanged() bool {
+	return x.endpoints
+}
+
+// StateChanged checks if the node state (online, offline, maintenance) changed.
+func (x NodeChange) StateChanged() bool {
+	return x.state
+}
+
+// Diff describes difference between two network maps. Nodes are identified
+// by public keys. Instances are created by NetMap.Diff.
+type Diff struct {
+	added, removed []NodeInfo
+
+	changed []NodeChange
+}
+
+// Added returns nodes presented in the next network map only in the order of
+// the next network map.
+func (x Diff) Added() []NodeInfo {
+	return x.added
+}
+
+// Removed returns nodes presented in the previous network map only in the
+// order of the previous network map.
+func (x Diff) Removed() []NodeInfo {
+	return x.removed
+}
+
+// Changed returns changes of the nodes presented in both network maps in the
+// order of the next network map.
+func (x Diff) Changed() []NodeChange {
+	return x.changed
+}
+
+// Empty checks if network maps have the same nodes with the same descriptors.
+// Note that epochs are not compared.
+func (x Diff) Empty() bool {
+	return len(x.added) == 0 && len(x.removed) == 0 && len(x.changed) == 0
+}
+
+// Diff compares the NetMap with the next one and returns the difference
+// between their nodes.
+func (m NetMap) Diff(next NetMap) Diff {
+	var res Diff
+
+	prev := make(map[string]int, len(m.nodes))
+	for i := range m.nodes {
+		prev[string(m.nodes[i].PublicKey())] = i
+	}
+
+	present := make(map[string]struct{}, len(next.nodes))
+
+	for i := range next.nodes {
+		key := string(next.nodes[i].PublicKey())
+		present[key] = struct{}{}
+
+		ind, ok := prev[key]
+		if !ok {
+			res.added = append(res.added, next.nodes[i])
+			continue
+		}
+
+		if change, ok := diffNodes(m.nodes[ind], next.nodes[i]); ok {
+			res.changed = append(res.changed, change)
+		}
+	}
+
+	for i := range m.nodes {
+		if _, ok := present[string(m.nodes[i].PublicKey())]; !ok {
+			res.removed = append(res.removed, m.nodes[i])
+		}
+	}
+
+	return res
+}
+
+// diffNodes returns changes of the node. Returns false if there are no
+// changes.
+func diffNodes(prev, next NodeInfo) (NodeChange, bool) {
+	res := NodeChange{
+		prev: prev,
+		next: next,
+	}
+
+	prevAttrs := make(map[string]string, prev.NumberOfAttributes())
+	prev.IterateAttributes(func(key, value string) {
+		prevAttrs[key] = value
+	})
+
+	next.IterateAttributes(func(key, value string) {
+		if prevValue := prevAttrs[key]; prevValue != value {
+			res.attrs = append(res.attrs, AttributeChange{
+				key:  key,
+				prev: prevValue,
+				next: value,
+			})
+		}
+
+		delete(prevAttrs, key)
+	})
+
+	// preserve order of the previous descriptor
+	prev.IterateAttributes(func(key, value string) {
+		if _, ok := prevAttrs[key]; ok {
+			res.attrs = append(res.attrs, AttributeChange{
+				key:  key,
+				prev: value,
+			})
+		}
+	})
+
+	res.endpoints = !equalEndpoints(prev, next)
+	res.state = prev.IsOnline() != next.IsOnline() ||
+		prev.IsOffline() != next.IsOffline() ||
+		prev.IsMaintenance() != next.IsMaintenance()
+
+	return res, len(res.attrs) != 0 || res.endpoints || res.state
+}
+
+func equalEndpoints(x, y NodeInfo) bool {
+	if x.NumberOfNetworkEndpoints() != y.NumberOfNetworkEndpoints() {
+		return false
+	}
+
+	var endpoints []string
+
+	x.IterateNetworkEndpoints(func(s string) bool {
+		endpoints = append(endpoints, s)
+		return false
+	})
+
+	i := 0
+	equal := true
+
+	y.IterateNetworkEndpoints(func(s string) bool {
+		equal = endpoints[i] == s
+		i++
+		return !equal
+	})
+
+	return equal
+}
+
+// ObjectRelocation describes change of the object placement.
+type ObjectRelocation struct {
+	id oid.ID
+
+	from, to []NodeInfo
+}
+
+// Object returns identifier of the relocated object.
+func (x ObjectRelocation) Object() oid.ID {
+	return x.id
+}
+
+// From returns nodes from the previous network map which no longer store
+// the object.
+func (x ObjectRelocation) From() []NodeInfo {
+	return x.from
+}
+
+// To returns nodes from the next network map which should receive the object.
+func (x ObjectRelocation) To() []NodeInfo {
+	return x.to
+}
+
+// PlanRelocation compares placement of the given container objects in the
+// NetMap and the next one according to the container's PlacementPolicy.
+// Object is stored on the first nodes of each placement vector (see
+// PlacementVectors): as many nodes as the corresponding replica descriptor
+// requires. Returns relocations of the objects whose placement changes in the
+// order of the given identifiers.
+//
+// Options are applied to both network maps as in ContainerNodes and
+// PlacementVectors.
+//
+// Returns an error if the policy can't be applied to any of the network maps.
+func (m NetMap) PlanRelocation(next NetMap, p PlacementPolicy, cnr cid.ID, objs []oid.ID, opts ...PlacementOption) ([]ObjectRelocation, error) {
+	pivot := make([]byte, 32)
+	cnr.Encode(pivot)
+
+	prevVectors, err := m.ContainerNodes(p, pivot, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("apply policy to the previous network map: %w", err)
+	}
+
+	nextVectors, err := next.ContainerNodes(p, pivot, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("apply policy to the next network map: %w", err)
+	}
+
+	var res []ObjectRelocation
+
+	obj := make([]byte, 32)
+
+	for i := range objs {
+		objs[i].Encode(obj)
+
+		prevNodes, err := m.objectNodes(p, prevVectors, obj, opts)
+		if err != nil {
+			return nil, fmt.Errorf("place object %s in the previous network map: %w", objs[i], err)
+		}
+
+		nextNodes, err := next.objectNodes(p, nextVectors, obj, opts)
+		if err != nil {
+			return nil, fmt.Errorf("place object %s in the next network map: %w", objs[i], err)
+		}
+
+		r := ObjectRelocation{
+			id:   objs[i],
+			from: subtractNodes(prevNodes, nextNodes),
+			to:   subtractNodes(nextNodes, prevNodes),
+		}
+
+		if len(r.from) != 0 || len(r.to) != 0 {
+			res = append(res, r)
+		}
+	}
+
+	return res, nil
+}
+
+// objectNodes returns list of the nodes storing the object according to the
+// policy.
+func (m NetMap) objectNodes(p PlacementPolicy, vectors [][]NodeInfo, pivot []byte, opts []PlacementOption) ([]NodeInfo, error) {
+	placement, err := m.PlacementVectors(vectors, pivot, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []NodeInfo
+
+	seen := make(map[string]struct{})
+
+	for i := range placement {
+		n := int(p.replicas[i].GetCount())
+		if n > len(placement[i]) {
+			n = len(placement[i])
+		}
+
+		for j := 0; j < n; j++ {
+			key := string(placement[i][j].PublicKey())
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				res = append(res, placement[i][j])
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// subtractNodes returns nodes from x which are absent in y.
+func subtractNodes(x, y []NodeInfo) []NodeInfo {
+	var res []NodeInfo
+
+loop:
+	for i := range x {
+		for j := range y {
+			if string(x[i].PublicKey()) == string(y[j].PublicKey()) {
+				continue loop
+			}
+		}
+
+		res = append(res, x[i])
+	}
+
+	return res
+}
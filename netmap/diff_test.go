@@ -0,0 +1,132 @@
+package netmap
+
+import (
+	"strconv"
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func diffNodeAttributes(i int) []string {
+	return []string{"Country", strconv.Itoa(i % 3), "Price", "1", "Capacity", "10"}
+}
+
+func TestNetMap_Diff(t *testing.T) {
+	var prev, next NetMap
+
+	prevNodes := nodeInfosFromAttributes(5, diffNodeAttributes)
+	prev.SetNodes(prevNodes)
+
+	require.True(t, prev.Diff(prev).Empty())
+
+	all := nodeInfosFromAttributes(6, diffNodeAttributes)
+	all[2].SetAttribute("Country", "X")
+	all[2].SetAttribute("Extra", "true")
+	all[3].SetNetworkEndpoints("/ip4/127.0.0.1/tcp/9000")
+	all[4].SetMaintenance()
+
+	// node #1 left, node #5 joined
+	nextNodes := []NodeInfo{all[0], all[2], all[3], all[4], all[5]}
+
+	next.SetNodes(nextNodes)
+
+	d := prev.Diff(next)
+	require.False(t, d.Empty())
+	require.Equal(t, []NodeInfo{prevNodes[1]}, d.Removed())
+	require.Len(t, d.Added(), 1)
+	require.Equal(t, []byte{5}, d.Added()[0].PublicKey()[:1])
+
+	changed := d.Changed()
+	require.Len(t, changed, 3)
+
+	require.Equal(t, prevNodes[2].PublicKey(), changed[0].PublicKey())
+	require.Equal(t, prevNodes[2], changed[0].Previous())
+	require.Equal(t, nextNodes[1], changed[0].Next())
+	require.Equal(t, []AttributeChange{
+		{key: "Country", prev: "2", next: "X"},
+		{key: "Extra", next: "true"},
+	}, changed[0].AttributeChanges())
+	require.False(t, changed[0].EndpointsChanged())
+	require.False(t, changed[0].StateChanged())
+
+	require.Empty(t, changed[1].AttributeChanges())
+	require.True(t, changed[1].EndpointsChanged())
+	require.False(t, changed[1].StateChanged())
+
+	require.Empty(t, changed[2].AttributeChanges())
+	require.False(t, changed[2].EndpointsChanged())
+	require.True(t, changed[2].StateChanged())
+
+	// attribute removal
+	removed := prevNodes[0]
+	removed.m.SetAttributes(removed.m.GetAttributes()[1:])
+
+	change, ok := diffNodes(prevNodes[0], removed)
+	require.True(t, ok)
+	require.Equal(t, []AttributeChange{{key: "Country", prev: "0"}}, change.AttributeChanges())
+}
+
+func TestNetMap_PlanRelocation(t *testing.T) {
+	var prev, next NetMap
+
+	prevNodes := nodeInfosFromAttributes(9, diffNodeAttributes)
+	prev.SetNodes(prevNodes)
+
+	var p PlacementPolicy
+	require.NoError(t, p.DecodeString(`REP 2
+CBF 1`))
+
+	cnr := cidtest.ID()
+
+	objs := make([]oid.ID, 100)
+	for i := range objs {
+		objs[i] = oidtest.ID()
+	}
+
+	res, err := prev.PlanRelocation(prev, p, cnr, objs)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	prevVectors, err := prev.ContainerNodes(p, cnr[:])
+	require.NoError(t, err)
+
+	// one of the container nodes leaves
+	removed := prevVectors[0][0].PublicKey()
+
+	var nextNodes []NodeInfo
+	for i := range prevNodes {
+		if string(prevNodes[i].PublicKey()) != string(removed) {
+			nextNodes = append(nextNodes, prevNodes[i])
+		}
+	}
+
+	next.SetNodes(nextNodes)
+
+	res, err = prev.PlanRelocation(next, p, cnr, objs)
+	require.NoError(t, err)
+
+	// all objects are stored on both container nodes
+	require.Len(t, res, len(objs))
+
+	for i := range res {
+		require.Equal(t, objs[i], res[i].Object())
+		require.Len(t, res[i].From(), 1)
+		require.Equal(t, removed, res[i].From()[0].PublicKey())
+		require.Len(t, res[i].To(), 1)
+		require.NotEqual(t, removed, res[i].To()[0].PublicKey())
+	}
+
+	p.SetContainerBackupFactor(10)
+
+	_, err = prev.PlanRelocation(next, p, cnr, objs)
+	require.NoError(t, err)
+
+	p = PlacementPolicy{}
+	require.NoError(t, p.DecodeString(`REP 9`))
+
+	_, err = prev.PlanRelocation(next, p, cnr, objs)
+	require.ErrorIs(t, err, errNotEnoughNodes)
+}
@@ -14,6 +14,9 @@ container creator.
 NetworkInfo type is dedicated to descriptive characterization of network state
 and settings.
 
+LocationDB is an UN/LOCODE database used to derive and verify location
+attributes of the storage nodes from their LOCODE.
+
 Instances can be also used to process NeoFS API V2 protocol messages
 (see neo.fs.v2.netmap package in https://github.com/nspcc-dev/neofs-api).
 
@@ -39,7 +39,11 @@ func (c *context) processFilter(f netmap.Filter, top bool) error {
 	inner := f.GetFilters()
 
 	switch op := f.GetOp(); op {
-	case netmap.AND, netmap.OR:
+	case netmap.AND, netmap.OR, opNOT:
+		if op == opNOT && len(inner) != 1 {
+			return fmt.Errorf("%w: %d", errInvalidNotFilter, len(inner))
+		}
+
 		for i := range inner {
 			if err := c.processFilter(inner[i], false); err != nil {
 				return fmt.Errorf("process inner filter #%d: %w", i, err)
@@ -53,7 +57,7 @@ func (c *context) processFilter(f netmap.Filter, top bool) error {
 		}
 
 		switch op {
-		case netmap.EQ, netmap.NE:
+		case netmap.EQ, netmap.NE, opLIKE:
 		case netmap.GT, netmap.GE, netmap.LT, netmap.LE:
 			val := f.GetValue()
 			n, err := strconv.ParseUint(val, 10, 64)
@@ -94,6 +98,14 @@ func (c *context) match(f *netmap.Filter, b NodeInfo) bool {
 		}
 
 		return f.GetOp() == netmap.AND
+	case opNOT:
+		inner := f.GetFilters()
+		fSub := &inner[0]
+		if name := inner[0].GetName(); name != "" {
+			fSub = c.processedFilters[name]
+		}
+
+		return !c.match(fSub, b)
 	default:
 		return c.matchKeyValue(f, b)
 	}
@@ -105,6 +117,8 @@ func (c *context) matchKeyValue(f *netmap.Filter, b NodeInfo) bool {
 		return b.Attribute(f.GetKey()) == f.GetValue()
 	case netmap.NE:
 		return b.Attribute(f.GetKey()) != f.GetValue()
+	case opLIKE:
+		return matchPattern(f.GetValue(), b.Attribute(f.GetKey()))
 	default:
 		var attr uint64
 
@@ -140,3 +154,34 @@ func (c *context) matchKeyValue(f *netmap.Filter, b NodeInfo) bool {
 	// will not happen if context was created from f (maybe panic?)
 	return false
 }
+
+// matchPattern checks if s matches the pattern where '*' matches any (including
+// empty) sequence of characters and other characters match themselves.
+func matchPattern(pattern, s string) bool {
+	// last position of '*' in pattern and corresponding position in s to
+	// backtrack to on mismatch
+	star, next := -1, 0
+	i, j := 0, 0
+
+	for j < len(s) {
+		switch {
+		case i < len(pattern) && pattern[i] == '*':
+			star, next = i, j
+			i++
+		case i < len(pattern) && pattern[i] == s[j]:
+			i++
+			j++
+		case star >= 0:
+			next++
+			i, j = star+1, next
+		default:
+			return false
+		}
+	}
+
+	for i < len(pattern) && pattern[i] == '*' {
+		i++
+	}
+
+	return i == len(pattern)
+}
@@ -63,6 +63,13 @@ func TestContext_ProcessFiltersInvalid(t *testing.T) {
 			newFilter("Main", "Rating", "3", 0),
 			errInvalidFilterOp,
 		},
+		{
+			"InvalidNOT",
+			newFilter("Main", "", "", opNOT,
+				newFilter("", "Rating", "3", netmap.EQ),
+				newFilter("", "Rating", "4", netmap.EQ)),
+			errInvalidNotFilter,
+		},
 		{
 			"InvalidName",
 			newFilter("*", "Rating", "3", netmap.GE),
@@ -93,3 +100,56 @@ func TestFilter_MatchSimple_InvalidOp(t *testing.T) {
 	f.m.SetOp(0)
 	require.False(t, c.match(&f.m, b))
 }
+
+func TestFilter_MatchNOTAndLIKE(t *testing.T) {
+	fs := []Filter{
+		newFilter("Europe", "Location", "Europe*", opLIKE),
+		newFilter("Main", "", "", netmap.AND,
+			newFilter("", "", "", opNOT,
+				newFilter("Europe", "", "", 0)),
+			newFilter("", "", "", opNOT,
+				newFilter("", "Country", "*land", opLIKE))),
+	}
+
+	c := newContext(NetMap{})
+	p := newPlacementPolicy(1, nil, nil, fs)
+	require.NoError(t, c.processFilters(p))
+
+	for _, tc := range []struct {
+		location, country string
+		europe, main      bool
+	}{
+		{"Europe/Berlin", "Germany", true, false},
+		{"Asia/Tokyo", "Japan", false, true},
+		{"Asia/Tokyo", "Thailand", false, false},
+		{"America/Detroit", "Germany", false, true},
+	} {
+		b := nodeInfoFromAttributes("Location", tc.location, "Country", tc.country)
+		require.Equal(t, tc.europe, c.match(c.processedFilters["Europe"], b), tc.location)
+		require.Equal(t, tc.main, c.match(c.processedFilters["Main"], b), tc.location)
+	}
+}
+
+func TestMatchPattern(t *testing.T) {
+	for _, tc := range []struct {
+		pattern, s string
+		match      bool
+	}{
+		{"", "", true},
+		{"", "a", false},
+		{"*", "", true},
+		{"*", "abc", true},
+		{"abc", "abc", true},
+		{"abc", "abd", false},
+		{"a*", "abc", true},
+		{"*c", "abc", true},
+		{"*b*", "abc", true},
+		{"a*c", "ac", true},
+		{"a*b*c", "aXbYbZc", true},
+		{"a*b*c", "aXbYbZ", false},
+		{"a**c", "abc", true},
+		{"*a", "bab", false},
+	} {
+		require.Equal(t, tc.match, matchPattern(tc.pattern, tc.s), "%q %q", tc.pattern, tc.s)
+	}
+}
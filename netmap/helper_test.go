@@ -47,3 +47,18 @@ func nodeInfoFromAttributes(props ...string) (n NodeInfo) {
 
 	return
 }
+
+// nodeInfosFromAttributes returns n nodes with distinct public keys. Attributes
+// of the i-th node are returned by props.
+func nodeInfosFromAttributes(n int, props func(i int) []string) []NodeInfo {
+	res := make([]NodeInfo, n)
+	for i := range res {
+		res[i] = nodeInfoFromAttributes(props(i)...)
+
+		pub := make([]byte, 33)
+		pub[0] = byte(i)
+		res[i].SetPublicKey(pub)
+	}
+
+	return res
+}
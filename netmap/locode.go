@@ -0,0 +1,483 @@
+package netmap
+
+import (
+	"embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// embeddedLocations contains compact UN/LOCODE dataset shipped with the
+// package. See LocationDB.LoadEmbedded.
+//
+//go:embed unlocode/*.csv
+var embeddedLocations embed.FS
+
+// Various location errors.
+var (
+	errInvalidLOCODE    = errors.New("invalid UN/LOCODE")
+	errUnknownLOCODE    = errors.New("unknown UN/LOCODE")
+	errNoCoordinates    = errors.New("missing location coordinates")
+	errLocationMismatch = errors.New("location attribute mismatch")
+)
+
+// earthRadius is a mean radius of the Earth in kilometers.
+const earthRadius = 6371.0
+
+// LocationRecord describes location from the UN/LOCODE database. Instances
+// are returned by LocationDB.Lookup.
+type LocationRecord struct {
+	country, location string
+
+	countryName string
+
+	name string
+
+	subDivCode, subDivName string
+
+	continent string
+
+	hasCoords bool
+	lat, lon  float64
+}
+
+// LOCODE returns location code in "CC LLL" format (see NodeInfo.SetLOCODE).
+func (x LocationRecord) LOCODE() string {
+	return x.country + " " + x.location
+}
+
+// CountryCode returns ISO 3166-1 alpha-2 code of the location country.
+func (x LocationRecord) CountryCode() string {
+	return x.country
+}
+
+// CountryName returns name of the location country.
+func (x LocationRecord) CountryName() string {
+	return x.countryName
+}
+
+// LocationName returns location name without diacritics.
+func (x LocationRecord) LocationName() string {
+	return x.name
+}
+
+// SubdivisionCode returns ISO 3166-2 code of the location subdivision without
+// country prefix. Empty if the location is not attributed to any subdivision.
+func (x LocationRecord) SubdivisionCode() string {
+	return x.subDivCode
+}
+
+// SubdivisionName returns name of the location subdivision. Empty if
+// the subdivision is unknown.
+func (x LocationRecord) SubdivisionName() string {
+	return x.subDivName
+}
+
+// Continent returns name of the location continent from Seven-Continent
+// model. Empty if the continent of the country is unknown.
+func (x LocationRecord) Continent() string {
+	return x.continent
+}
+
+// Coordinates returns latitude and longitude of the location in degrees.
+// Returns false if the location has no coordinates.
+func (x LocationRecord) Coordinates() (lat, lon float64, ok bool) {
+	return x.lat, x.lon, x.hasCoords
+}
+
+// locationEntry is a location row of the UN/LOCODE code list.
+type locationEntry struct {
+	name, subDiv string
+
+	hasCoords bool
+	lat, lon  float64
+}
+
+// LocationDB is an in-memory UN/LOCODE database used to derive location
+// attributes of the storage nodes. Database is filled from files in
+// UN/LOCODE formats: repeated loads add new records and overwrite the existing
+// ones, so database can be updated from the newer releases.
+//
+// Zero LocationDB is empty. LocationDB must not be loaded concurrently with
+// other operations.
+type LocationDB struct {
+	countries map[string]string
+
+	locations map[string]locationEntry
+
+	subdivisions map[string]string
+
+	continents map[string]string
+}
+
+// LoadEmbedded loads compact dataset shipped with the package. The dataset
+// covers the biggest cities of the countries where NeoFS nodes are usually
+// deployed and continents of all countries. Applications requiring full
+// coverage should load the official UN/LOCODE release.
+func (x *LocationDB) LoadEmbedded() error {
+	for _, f := range []struct {
+		name string
+		load func(io.Reader) error
+	}{
+		{"unlocode/codelist.csv", x.LoadCodeList},
+		{"unlocode/subdivisions.csv", x.LoadSubdivisions},
+		{"unlocode/continents.csv", x.LoadContinents},
+	} {
+		r, err := embeddedLocations.Open(f.name)
+		if err != nil {
+			return fmt.Errorf("open embedded file %s: %w", f.name, err)
+		}
+
+		err = f.load(r)
+		_ = r.Close()
+
+		if err != nil {
+			return fmt.Errorf("load embedded file %s: %w", f.name, err)
+		}
+	}
+
+	return nil
+}
+
+// LoadCodeList reads UN/LOCODE code list in CSV format of the official
+// release (UTF-8 encoded): Change, Country, Location, Name, NameWoDiacritics,
+// SubDiv, Function, Status, Date, IATA, Coordinates and Remarks columns.
+// Country rows (with empty Location) define country names. Upper-case names
+// of the official release are converted into ISO 3166 short name format, e.g.
+// "RUSSIAN FEDERATION" into "Russian Federation". Entries marked for deletion
+// and references to other entries are skipped.
+func (x *LocationDB) LoadCodeList(r io.Reader) error {
+	if x.countries == nil {
+		x.countries = make(map[string]string)
+		x.locations = make(map[string]locationEntry)
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	for line := 1; ; line++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if len(rec) < 11 {
+			return fmt.Errorf("line %d: invalid number of columns %d", line, len(rec))
+		}
+
+		switch rec[0] {
+		case "X", "=":
+			continue
+		}
+
+		if len(rec[1]) != 2 {
+			return fmt.Errorf("line %d: invalid country code '%s'", line, rec[1])
+		}
+
+		if rec[2] == "" {
+			x.countries[rec[1]] = countryName(strings.TrimPrefix(rec[3], "."))
+			continue
+		}
+
+		if len(rec[2]) != 3 {
+			return fmt.Errorf("line %d: invalid location code '%s'", line, rec[2])
+		}
+
+		e := locationEntry{
+			name:   rec[4],
+			subDiv: rec[5],
+		}
+
+		if rec[10] != "" {
+			e.lat, e.lon, err = parseCoordinates(rec[10])
+			if err != nil {
+				return fmt.Errorf("line %d: invalid coordinates '%s': %w", line, rec[10], err)
+			}
+
+			e.hasCoords = true
+		}
+
+		x.locations[rec[1]+" "+rec[2]] = e
+	}
+}
+
+// LoadSubdivisions reads UN/LOCODE subdivision list in CSV format of the
+// official release (UTF-8 encoded): Country, Code, Name and Type columns.
+func (x *LocationDB) LoadSubdivisions(r io.Reader) error {
+	if x.subdivisions == nil {
+		x.subdivisions = make(map[string]string)
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	for line := 1; ; line++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if len(rec) < 3 {
+			return fmt.Errorf("line %d: invalid number of columns %d", line, len(rec))
+		}
+
+		x.subdivisions[rec[0]+" "+rec[1]] = rec[2]
+	}
+}
+
+// LoadContinents reads mapping of the countries to the continents in CSV
+// format: country code (ISO 3166-1 alpha-2) and continent name columns.
+// Continent names are the ones used by the NeoFS nodes: words are separated
+// by underscores, e.g. "North_America". UN/LOCODE doesn't provide the mapping,
+// so it is maintained separately.
+func (x *LocationDB) LoadContinents(r io.Reader) error {
+	if x.continents == nil {
+		x.continents = make(map[string]string)
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 2
+
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		x.continents[rec[0]] = rec[1]
+	}
+}
+
+// Lookup returns record of the location identified by the LOCODE in "CC LLL"
+// format (see NodeInfo.SetLOCODE). Returns an error if the LOCODE is invalid
+// or missing in the database.
+func (x LocationDB) Lookup(locode string) (LocationRecord, error) {
+	var res LocationRecord
+
+	if len(locode) != 6 || locode[2] != ' ' {
+		return res, fmt.Errorf("%w: '%s'", errInvalidLOCODE, locode)
+	}
+
+	e, ok := x.locations[locode]
+	if !ok {
+		return res, fmt.Errorf("%w: '%s'", errUnknownLOCODE, locode)
+	}
+
+	res.country = locode[:2]
+	res.location = locode[3:]
+	res.countryName = x.countries[res.country]
+	res.name = e.name
+	res.subDivCode = e.subDiv
+	res.continent = x.continents[res.country]
+	res.hasCoords = e.hasCoords
+	res.lat = e.lat
+	res.lon = e.lon
+
+	if e.subDiv != "" {
+		res.subDivName = x.subdivisions[res.country+" "+e.subDiv]
+	}
+
+	return res, nil
+}
+
+// Continent returns continent of the country identified by ISO 3166-1
+// alpha-2 code. Returns empty string if the country is unknown.
+func (x LocationDB) Continent(countryCode string) string {
+	return x.continents[countryCode]
+}
+
+// Distance returns great-circle distance in kilometers between the locations
+// identified by LOCODEs. Returns an error if any location is missing or has
+// no coordinates.
+func (x LocationDB) Distance(a, b string) (float64, error) {
+	var coords [2][2]float64
+
+	for i, locode := range [2]string{a, b} {
+		rec, err := x.Lookup(locode)
+		if err != nil {
+			return 0, err
+		}
+
+		var ok bool
+
+		coords[i][0], coords[i][1], ok = rec.Coordinates()
+		if !ok {
+			return 0, fmt.Errorf("%w: '%s'", errNoCoordinates, locode)
+		}
+	}
+
+	const rad = math.Pi / 180
+
+	lat1, lon1 := coords[0][0]*rad, coords[0][1]*rad
+	lat2, lon2 := coords[1][0]*rad, coords[1][1]*rad
+
+	// haversine formula
+	h := math.Pow(math.Sin((lat2-lat1)/2), 2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin((lon2-lon1)/2), 2)
+
+	return 2 * earthRadius * math.Asin(math.Sqrt(h)), nil
+}
+
+// parseCoordinates parses UN/LOCODE coordinates in "DDMM[NS] DDDMM[EW]"
+// format into latitude and longitude in degrees.
+func parseCoordinates(s string) (lat, lon float64, err error) {
+	parts := strings.Split(s, " ")
+	if len(parts) != 2 || len(parts[0]) != 5 || len(parts[1]) != 6 {
+		return 0, 0, errors.New("invalid format")
+	}
+
+	lat, err = parseCoordinate(parts[0], 90, 'N', 'S')
+	if err != nil {
+		return 0, 0, fmt.Errorf("latitude: %w", err)
+	}
+
+	lon, err = parseCoordinate(parts[1], 180, 'E', 'W')
+	if err != nil {
+		return 0, 0, fmt.Errorf("longitude: %w", err)
+	}
+
+	return lat, lon, nil
+}
+
+// parseCoordinate parses degrees and minutes followed by the hemisphere
+// letter.
+func parseCoordinate(s string, limit float64, pos, neg byte) (float64, error) {
+	deg, err := strconv.ParseUint(s[:len(s)-3], 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid degrees: %w", err)
+	}
+
+	mins, err := strconv.ParseUint(s[len(s)-3:len(s)-1], 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid minutes: %w", err)
+	} else if mins >= 60 {
+		return 0, fmt.Errorf("invalid minutes %d", mins)
+	}
+
+	res := float64(deg) + float64(mins)/60
+	if res > limit {
+		return 0, fmt.Errorf("value %v out of range", res)
+	}
+
+	switch s[len(s)-1] {
+	case pos:
+	case neg:
+		res = -res
+	default:
+		return 0, fmt.Errorf("invalid hemisphere '%c'", s[len(s)-1])
+	}
+
+	return res, nil
+}
+
+// countryParticles are the words written in lower case in ISO 3166 short
+// names unless they start the name.
+var countryParticles = map[string]struct{}{
+	"and": {},
+	"da":  {},
+	"of":  {},
+	"the": {},
+}
+
+// countryName converts upper-case country name of the UN/LOCODE release into
+// ISO 3166 short name format: words are capitalized except for particles.
+// Names which are not in upper case are returned as is.
+func countryName(name string) string {
+	if name != strings.ToUpper(name) {
+		return name
+	}
+
+	words := strings.Fields(strings.ToLower(name))
+
+	for i, w := range words {
+		if _, ok := countryParticles[strings.Trim(w, "(),")]; ok && i > 0 {
+			continue
+		}
+
+		b := []rune(w)
+		for j := range b {
+			// parts of the hyphenated words and abbreviations are capitalized too
+			if j == 0 || b[j-1] == '-' || b[j-1] == '(' || b[j-1] == '.' {
+				b[j] = unicode.ToUpper(b[j])
+			}
+		}
+
+		words[i] = string(b)
+	}
+
+	return strings.Join(words, " ")
+}
+
+// locationAttributes returns list of the node attributes derived from
+// the location record in the order of key-value pairs.
+func locationAttributes(rec LocationRecord) [][2]string {
+	return [][2]string{
+		{attrCountryCode, rec.CountryCode()},
+		{attrCountryName, rec.CountryName()},
+		{attrLocationName, rec.LocationName()},
+		{attrSubDivCode, rec.SubdivisionCode()},
+		{attrSubDivName, rec.SubdivisionName()},
+		{attrContinent, rec.Continent()},
+	}
+}
+
+// FillLocation sets all location attributes of the node derived from its
+// LOCODE (see SetLOCODE) using the database: country code and name, location
+// name, subdivision code and name, and continent. Attributes not defined for
+// the location are removed.
+//
+// FillLocation is intended only for processing the network registration
+// request by the Inner Ring. Other parties SHOULD NOT use it.
+//
+// See also VerifyLocation.
+func (x *NodeInfo) FillLocation(db LocationDB) error {
+	rec, err := db.Lookup(x.LOCODE())
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range locationAttributes(rec) {
+		if kv[1] != "" {
+			x.SetAttribute(kv[0], kv[1])
+		} else {
+			x.removeAttribute(kv[0])
+		}
+	}
+
+	return nil
+}
+
+// VerifyLocation checks that the location attributes set in the node agree
+// with its LOCODE according to the database. Missing attributes are not
+// checked. Country names are compared case-insensitively, so names in
+// upper-case UN/LOCODE format are accepted too.
+//
+// See also FillLocation.
+func (x NodeInfo) VerifyLocation(db LocationDB) error {
+	rec, err := db.Lookup(x.LOCODE())
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range locationAttributes(rec) {
+		val := x.Attribute(kv[0])
+		if val == "" || val == kv[1] || kv[0] == attrCountryName && strings.EqualFold(val, kv[1]) {
+			continue
+		}
+
+		return fmt.Errorf("%w: %s: expected '%s', got '%s'", errLocationMismatch, kv[0], kv[1], val)
+	}
+
+	return nil
+}
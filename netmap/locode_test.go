@@ -0,0 +1,193 @@
+package netmap
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocationDB_Lookup(t *testing.T) {
+	var db LocationDB
+
+	_, err := db.Lookup("RU MOW")
+	require.ErrorIs(t, err, errUnknownLOCODE)
+
+	require.NoError(t, db.LoadEmbedded())
+
+	for _, s := range []string{"", "RUMOW", "RU MOWW", "RU-MOW"} {
+		_, err = db.Lookup(s)
+		require.ErrorIs(t, err, errInvalidLOCODE, s)
+	}
+
+	_, err = db.Lookup("RU XXX")
+	require.ErrorIs(t, err, errUnknownLOCODE)
+
+	rec, err := db.Lookup("RU LED")
+	require.NoError(t, err)
+	require.Equal(t, "RU LED", rec.LOCODE())
+	require.Equal(t, "RU", rec.CountryCode())
+	require.Equal(t, "Russian Federation", rec.CountryName())
+	require.Equal(t, "Saint Petersburg (ex Leningrad)", rec.LocationName())
+	require.Equal(t, "SPE", rec.SubdivisionCode())
+	require.Equal(t, "Sankt-Peterburg", rec.SubdivisionName())
+	require.Equal(t, "Europe", rec.Continent())
+
+	lat, lon, ok := rec.Coordinates()
+	require.True(t, ok)
+	require.InDelta(t, 59.9167, lat, 1e-3)
+	require.InDelta(t, 30.25, lon, 1e-3)
+
+	rec, err = db.Lookup("BR SAO")
+	require.NoError(t, err)
+	require.Equal(t, "South_America", rec.Continent())
+
+	lat, lon, ok = rec.Coordinates()
+	require.True(t, ok)
+	require.Negative(t, lat)
+	require.Negative(t, lon)
+
+	rec, err = db.Lookup("SG SIN")
+	require.NoError(t, err)
+	require.Empty(t, rec.SubdivisionCode())
+	require.Empty(t, rec.SubdivisionName())
+
+	require.Equal(t, "Asia", db.Continent("JP"))
+	require.Equal(t, "Oceania", db.Continent("NZ"))
+	require.Equal(t, "North_America", db.Continent("US"))
+	require.Empty(t, db.Continent("XX"))
+}
+
+func TestLocationDB_Update(t *testing.T) {
+	var db LocationDB
+	require.NoError(t, db.LoadEmbedded())
+
+	require.NoError(t, db.LoadCodeList(strings.NewReader(`,"RU","MOW","Moskva","Moscow","MOW","1234----","AI","2301","",,""
+X,"RU","KZN","Kazan","Kazan","TA","1234----","AI","2301","","0000N 00000E",""
+,"RU","NSK","Novosibirsk","Novosibirsk","NVS","1234----","AI","2301","","5502N 08255E",""
+`)))
+
+	rec, err := db.Lookup("RU MOW")
+	require.NoError(t, err)
+	require.Equal(t, "Moscow", rec.LocationName())
+
+	_, _, ok := rec.Coordinates()
+	require.False(t, ok)
+
+	_, err = db.Distance("RU MOW", "RU LED")
+	require.ErrorIs(t, err, errNoCoordinates)
+
+	// deletion marks are ignored
+	rec, err = db.Lookup("RU KZN")
+	require.NoError(t, err)
+	lat, _, _ := rec.Coordinates()
+	require.NotZero(t, lat)
+
+	rec, err = db.Lookup("RU NSK")
+	require.NoError(t, err)
+	require.Equal(t, "Russian Federation", rec.CountryName())
+	require.Empty(t, rec.SubdivisionName())
+
+	require.NoError(t, db.LoadSubdivisions(strings.NewReader(`"RU","NVS","Novosibirskaya oblast'","Administrative region"`)))
+
+	rec, err = db.Lookup("RU NSK")
+	require.NoError(t, err)
+	require.Equal(t, "Novosibirskaya oblast'", rec.SubdivisionName())
+
+	for _, s := range []string{
+		`,"RU","MOW"`,
+		`,"RUS","MOW","Moskva","Moscow","MOW","1234----","AI","2301","",,""`,
+		`,"RU","MO","Moskva","Moscow","MOW","1234----","AI","2301","",,""`,
+		`,"RU","MOW","Moskva","Moscow","MOW","1234----","AI","2301","","5545N03737E",""`,
+		`,"RU","MOW","Moskva","Moscow","MOW","1234----","AI","2301","","5575N 03737E",""`,
+		`,"RU","MOW","Moskva","Moscow","MOW","1234----","AI","2301","","9145N 03737E",""`,
+		`,"RU","MOW","Moskva","Moscow","MOW","1234----","AI","2301","","5545N 03737S",""`,
+	} {
+		require.Error(t, db.LoadCodeList(strings.NewReader(s)), s)
+	}
+
+	require.Error(t, db.LoadContinents(strings.NewReader(`"RU"`)))
+}
+
+func TestLocationDB_Distance(t *testing.T) {
+	var db LocationDB
+	require.NoError(t, db.LoadEmbedded())
+
+	d, err := db.Distance("RU MOW", "RU MOW")
+	require.NoError(t, err)
+	require.Zero(t, d)
+
+	d, err = db.Distance("RU MOW", "RU LED")
+	require.NoError(t, err)
+	require.InDelta(t, 634, d, 10)
+
+	d2, err := db.Distance("RU LED", "RU MOW")
+	require.NoError(t, err)
+	require.InDelta(t, d, d2, 1e-9)
+
+	// crosses the prime meridian
+	d, err = db.Distance("GB LON", "FR PAR")
+	require.NoError(t, err)
+	require.InDelta(t, 343, d, 10)
+
+	_, err = db.Distance("RU MOW", "RU XXX")
+	require.ErrorIs(t, err, errUnknownLOCODE)
+}
+
+func TestNodeInfo_FillLocation(t *testing.T) {
+	var db LocationDB
+	require.NoError(t, db.LoadEmbedded())
+
+	var n NodeInfo
+
+	require.ErrorIs(t, n.FillLocation(db), errInvalidLOCODE)
+	require.ErrorIs(t, n.VerifyLocation(db), errInvalidLOCODE)
+
+	n.SetLOCODE("DE FRA")
+	require.NoError(t, n.VerifyLocation(db))
+
+	n.SetCountryName("France")
+	require.ErrorIs(t, n.VerifyLocation(db), errLocationMismatch)
+
+	require.NoError(t, n.FillLocation(db))
+	require.NoError(t, n.VerifyLocation(db))
+
+	require.Equal(t, "DE", n.Attribute(attrCountryCode))
+	require.Equal(t, "Germany", n.Attribute(attrCountryName))
+	require.Equal(t, "Frankfurt am Main", n.Attribute(attrLocationName))
+	require.Equal(t, "HE", n.Attribute(attrSubDivCode))
+	require.Equal(t, "Hessen", n.Attribute(attrSubDivName))
+	require.Equal(t, "Europe", n.Attribute(attrContinent))
+
+	// country names of UN/LOCODE format are accepted
+	n.SetCountryName("GERMANY")
+	require.NoError(t, n.VerifyLocation(db))
+
+	n.SetContinentName("North_America")
+	require.ErrorIs(t, n.VerifyLocation(db), errLocationMismatch)
+
+	// attributes undefined for the new location are removed
+	n.SetLOCODE("SG SIN")
+	require.ErrorIs(t, n.VerifyLocation(db), errLocationMismatch)
+
+	require.NoError(t, n.FillLocation(db))
+	require.NoError(t, n.VerifyLocation(db))
+	require.Empty(t, n.Attribute(attrSubDivCode))
+	require.Empty(t, n.Attribute(attrSubDivName))
+	require.Equal(t, "Asia", n.Attribute(attrContinent))
+	require.Equal(t, 5, n.NumberOfAttributes())
+}
+
+func TestCountryName(t *testing.T) {
+	for _, tc := range []struct{ name, exp string }{
+		{"RUSSIAN FEDERATION", "Russian Federation"},
+		{"KOREA, REPUBLIC OF", "Korea, Republic of"},
+		{"CONGO, THE DEMOCRATIC REPUBLIC OF THE", "Congo, the Democratic Republic of the"},
+		{"SAINT HELENA, ASCENSION AND TRISTAN DA CUNHA", "Saint Helena, Ascension and Tristan da Cunha"},
+		{"GUINEA-BISSAU", "Guinea-Bissau"},
+		{"VIRGIN ISLANDS (U.S.)", "Virgin Islands (U.S.)"},
+		{"Netherlands (the)", "Netherlands (the)"},
+	} {
+		require.Equal(t, tc.exp, countryName(tc.name), tc.name)
+	}
+}
@@ -5,6 +5,10 @@ import (
 
 	"github.com/nspcc-dev/hrw"
 	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	netmapgrpc "github.com/nspcc-dev/neofs-api-go/v2/netmap/grpc"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/message"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
 // NetMap represents NeoFS network map. It includes information about all
@@ -20,11 +24,7 @@ type NetMap struct {
 	nodes []NodeInfo
 }
 
-// ReadFromV2 reads NetMap from the netmap.NetMap message. Checks if the
-// message conforms to NeoFS API V2 protocol.
-//
-// See also WriteToV2.
-func (m *NetMap) ReadFromV2(msg netmap.NetMap) error {
+func (m *NetMap) readFromV2(msg netmap.NetMap, checkFieldPresence bool) error {
 	var err error
 	nodes := msg.Nodes()
 
@@ -34,7 +34,7 @@ func (m *NetMap) ReadFromV2(msg netmap.NetMap) error {
 		m.nodes = make([]NodeInfo, len(nodes))
 
 		for i := range nodes {
-			err = m.nodes[i].ReadFromV2(nodes[i])
+			err = m.nodes[i].readFromV2(nodes[i], checkFieldPresence)
 			if err != nil {
 				return fmt.Errorf("invalid node info: %w", err)
 			}
@@ -46,6 +46,14 @@ func (m *NetMap) ReadFromV2(msg netmap.NetMap) error {
 	return nil
 }
 
+// ReadFromV2 reads NetMap from the netmap.NetMap message. Checks if the
+// message conforms to NeoFS API V2 protocol.
+//
+// See also WriteToV2.
+func (m *NetMap) ReadFromV2(msg netmap.NetMap) error {
+	return m.readFromV2(msg, true)
+}
+
 // WriteToV2 writes NetMap to the netmap.NetMap message. The message
 // MUST NOT be nil.
 //
@@ -66,6 +74,60 @@ func (m NetMap) WriteToV2(msg *netmap.NetMap) {
 	msg.SetEpoch(m.epoch)
 }
 
+// Marshal encodes NetMap into a binary format of the NeoFS API protocol
+// (Protocol Buffers with direct field order). Encoding includes the epoch
+// and is stable: the same NetMap is always encoded into the same bytes.
+//
+// See also Unmarshal.
+func (m NetMap) Marshal() []byte {
+	var msg netmap.NetMap
+	m.WriteToV2(&msg)
+
+	return msg.StableMarshal(nil)
+}
+
+// Unmarshal decodes NeoFS API protocol binary format into the NetMap
+// (Protocol Buffers with direct field order). Returns an error describing
+// a format violation.
+//
+// See also Marshal.
+func (m *NetMap) Unmarshal(data []byte) error {
+	var msg netmap.NetMap
+
+	err := message.Unmarshal(&msg, data, new(netmapgrpc.Netmap))
+	if err != nil {
+		return err
+	}
+
+	return m.readFromV2(msg, false)
+}
+
+// MarshalJSON encodes NetMap into a JSON format of the NeoFS API protocol
+// (Protocol Buffers JSON).
+//
+// See also UnmarshalJSON.
+func (m NetMap) MarshalJSON() ([]byte, error) {
+	var msg netmap.NetMap
+	m.WriteToV2(&msg)
+
+	return message.MarshalJSON(&msg)
+}
+
+// UnmarshalJSON decodes NeoFS API protocol JSON format into the NetMap
+// (Protocol Buffers JSON). Returns an error describing a format violation.
+//
+// See also MarshalJSON.
+func (m *NetMap) UnmarshalJSON(data []byte) error {
+	var msg netmap.NetMap
+
+	err := message.UnmarshalJSON(&msg, data, new(netmapgrpc.Netmap))
+	if err != nil {
+		return err
+	}
+
+	return m.readFromV2(msg, false)
+}
+
 // SetNodes sets information list about all storage nodes from the NeoFS network.
 //
 // Argument MUST NOT be mutated, make a copy first.
@@ -114,7 +176,7 @@ func (n nodes) Hash() uint64 {
 }
 
 // weights returns slice of nodes weights W.
-func (n nodes) weights(wf weightFunc) []float64 {
+func (n nodes) weights(wf WeightFunc) []float64 {
 	w := make([]float64, 0, len(n))
 	for i := range n {
 		w = append(w, wf(n[i]))
@@ -144,9 +206,12 @@ func flattenNodes(ns []nodes) nodes {
 // For example, in order to build node list to store the object, binary-encoded
 // object identifier can be used as pivot. Result is deterministic for
 // the fixed NetMap and parameters.
-func (m NetMap) PlacementVectors(vectors [][]NodeInfo, pivot []byte) ([][]NodeInfo, error) {
+//
+// Nodes are weighted by DefaultWeighting by default, use WithWeighting option
+// to override. Options MUST be the same as for ContainerNodes.
+func (m NetMap) PlacementVectors(vectors [][]NodeInfo, pivot []byte, opts ...PlacementOption) ([][]NodeInfo, error) {
 	h := hrw.Hash(pivot)
-	wf := defaultWeightFunc(m.nodes)
+	wf := applyPlacementOptions(opts).weighting.WeightFunc(m.nodes)
 	result := make([][]NodeInfo, len(vectors))
 
 	for i := range vectors {
@@ -165,11 +230,20 @@ func (m NetMap) PlacementVectors(vectors [][]NodeInfo, pivot []byte) ([][]NodeIn
 // the policy, and then selected by Selector list. Result is deterministic for
 // the fixed NetMap and parameters.
 //
+// If the policy requires unique nodes (see PlacementPolicy.SetUnique), nodes
+// are selected for the replica descriptors in order, and the nodes selected
+// for one descriptor are excluded from the selections for the next ones.
+//
+// Options allow to change weighting of the nodes and buckets (see
+// PlacementOption). By default, nodes are weighted by DefaultWeighting.
+//
 // Result can be used in PlacementVectors.
-func (m NetMap) ContainerNodes(p PlacementPolicy, pivot []byte) ([][]NodeInfo, error) {
+func (m NetMap) ContainerNodes(p PlacementPolicy, pivot []byte, opts ...PlacementOption) ([][]NodeInfo, error) {
 	c := newContext(m)
+	c.setPlacementOptions(opts)
 	c.setPivot(pivot)
 	c.setCBF(p.backupFactor)
+	c.setUnique(p.unique)
 
 	if err := c.processFilters(p); err != nil {
 		return nil, err
@@ -194,6 +268,7 @@ func (m NetMap) ContainerNodes(p PlacementPolicy, pivot []byte) ([][]NodeInfo, e
 					return nil, err
 				}
 
+				c.addUsedNodes(nodes...)
 				result[i] = flattenNodes(nodes)
 			}
 
@@ -209,8 +284,41 @@ func (m NetMap) ContainerNodes(p PlacementPolicy, pivot []byte) ([][]NodeInfo, e
 			return nil, fmt.Errorf("selector not found: REPLICA '%s'", sName)
 		}
 
+		if p.unique {
+			var err error
+
+			// selection is repeated with the nodes of the previous replicas excluded
+			nodes, err = c.getSelection(p, *c.processedSelectors[sName])
+			if err != nil {
+				return nil, err
+			}
+
+			c.addUsedNodes(nodes...)
+		}
+
 		result[i] = append(result[i], flattenNodes(nodes)...)
 	}
 
 	return result, nil
 }
+
+// PlaceObject applies the container PlacementPolicy to the NetMap and returns
+// placement vectors of the object: ContainerNodes with container identifier as
+// a pivot sorted by PlacementVectors for the object identifier. The object is
+// stored on the first nodes of each vector: as many nodes as the corresponding
+// replica descriptor requires.
+//
+// Options are applied as in ContainerNodes and PlacementVectors.
+func (m NetMap) PlaceObject(p PlacementPolicy, cnr cid.ID, obj oid.ID, opts ...PlacementOption) ([][]NodeInfo, error) {
+	pivot := make([]byte, 32)
+	cnr.Encode(pivot)
+
+	vectors, err := m.ContainerNodes(p, pivot, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("select container nodes: %w", err)
+	}
+
+	obj.Encode(pivot)
+
+	return m.PlacementVectors(vectors, pivot, opts...)
+}
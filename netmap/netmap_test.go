@@ -4,8 +4,10 @@ import (
 	"testing"
 
 	v2netmap "github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -45,3 +47,61 @@ func TestNetMap_SetEpoch(t *testing.T) {
 
 	require.EqualValues(t, e, m.Epoch())
 }
+
+func TestNetMap_Marshal(t *testing.T) {
+	nm := netmaptest.NetMap()
+
+	data := nm.Marshal()
+	require.Equal(t, data, nm.Marshal())
+
+	var nm2 netmap.NetMap
+	require.NoError(t, nm2.Unmarshal(data))
+	require.Equal(t, nm, nm2)
+
+	require.Error(t, nm2.Unmarshal([]byte("definitely not a protobuf")))
+
+	// zero network map
+	nm = netmap.NetMap{}
+	require.NoError(t, nm2.Unmarshal(nm.Marshal()))
+	require.Equal(t, nm, nm2)
+}
+
+func TestNetMap_MarshalJSON(t *testing.T) {
+	nm := netmaptest.NetMap()
+
+	data, err := nm.MarshalJSON()
+	require.NoError(t, err)
+
+	var nm2 netmap.NetMap
+	require.NoError(t, nm2.UnmarshalJSON(data))
+	require.Equal(t, nm, nm2)
+
+	require.Error(t, nm2.UnmarshalJSON([]byte("{")))
+}
+
+func TestNetMap_PlaceObject(t *testing.T) {
+	var nm netmap.NetMap
+	nm.SetNodes([]netmap.NodeInfo{netmaptest.NodeInfo(), netmaptest.NodeInfo(), netmaptest.NodeInfo()})
+
+	var p netmap.PlacementPolicy
+	require.NoError(t, p.DecodeString(`REP 2
+CBF 1`))
+
+	cnr := cidtest.ID()
+	obj := oidtest.ID()
+
+	res, err := nm.PlaceObject(p, cnr, obj)
+	require.NoError(t, err)
+
+	vectors, err := nm.ContainerNodes(p, cnr[:])
+	require.NoError(t, err)
+
+	vectors, err = nm.PlacementVectors(vectors, obj[:])
+	require.NoError(t, err)
+	require.Equal(t, vectors, res)
+
+	require.NoError(t, p.DecodeString(`REP 4`))
+
+	_, err = nm.PlaceObject(p, cnr, obj)
+	require.Error(t, err)
+}
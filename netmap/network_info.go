@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
+	"time"
 
 	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
 	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
@@ -150,6 +152,43 @@ func (x *NetworkInfo) SetMsPerBlock(v int64) {
 	x.m.SetMsPerBlock(v)
 }
 
+// EpochCovering returns number of the earliest epoch which is guaranteed to
+// end not earlier than d after the current moment. The calculation relies on
+// EpochDuration and MsPerBlock network parameters and assumes that the
+// CurrentEpoch has already started. Returns false if any of the parameters
+// is missing.
+//
+// Non-positive d corresponds to the CurrentEpoch.
+func (x NetworkInfo) EpochCovering(d time.Duration) (uint64, bool) {
+	msPerBlock := x.MsPerBlock()
+	epochDur := x.EpochDuration()
+
+	if msPerBlock <= 0 || epochDur == 0 {
+		return 0, false
+	}
+
+	cur := x.CurrentEpoch()
+	if d <= 0 {
+		return cur, true
+	}
+
+	epochMs := new(big.Int).Mul(big.NewInt(msPerBlock), new(big.Int).SetUint64(epochDur))
+
+	// current epoch may end right now, so each full epoch duration within d
+	// requires one more epoch
+	n, rem := new(big.Int).QuoRem(big.NewInt(d.Milliseconds()), epochMs, new(big.Int))
+	if rem.Sign() > 0 || n.Sign() == 0 {
+		n.Add(n, big.NewInt(1))
+	}
+
+	n.Add(n, new(big.Int).SetUint64(cur))
+	if !n.IsUint64() {
+		return math.MaxUint64, true
+	}
+
+	return n.Uint64(), true
+}
+
 func (x *NetworkInfo) setConfig(name string, val []byte) {
 	c := x.m.GetNetworkConfig()
 	if c == nil {
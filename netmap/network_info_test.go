@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
 	. "github.com/nspcc-dev/neofs-sdk-go/netmap"
@@ -27,6 +28,40 @@ func TestNetworkInfo_CurrentEpoch(t *testing.T) {
 	require.EqualValues(t, e, m.GetCurrentEpoch())
 }
 
+func TestNetworkInfo_EpochCovering(t *testing.T) {
+	var x NetworkInfo
+
+	_, ok := x.EpochCovering(time.Hour)
+	require.False(t, ok)
+
+	x.SetCurrentEpoch(10)
+	x.SetEpochDuration(240)
+	x.SetMsPerBlock(15000) // 1h epochs
+
+	for _, tc := range []struct {
+		d   time.Duration
+		exp uint64
+	}{
+		{d: -time.Second, exp: 10},
+		{d: 0, exp: 10},
+		{d: time.Nanosecond, exp: 11},
+		{d: time.Minute, exp: 11},
+		{d: time.Hour, exp: 11},
+		{d: time.Hour + time.Millisecond, exp: 12},
+		{d: 24 * time.Hour, exp: 34},
+	} {
+		e, ok := x.EpochCovering(tc.d)
+		require.True(t, ok)
+		require.EqualValues(t, tc.exp, e, tc.d)
+	}
+
+	x.SetCurrentEpoch(math.MaxUint64 - 1)
+
+	e, ok := x.EpochCovering(24 * time.Hour)
+	require.True(t, ok)
+	require.EqualValues(t, uint64(math.MaxUint64), e)
+}
+
 func TestNetworkInfo_MagicNumber(t *testing.T) {
 	var x NetworkInfo
 
@@ -53,8 +53,6 @@ func (x *NodeInfo) readFromV2(m netmap.NodeInfo, checkFieldPresence bool) error
 			return fmt.Errorf("duplicated attbiuted %s", key)
 		}
 
-		const subnetPrefix = "__NEOFS__SUBNET_"
-
 		switch {
 		case key == attrCapacity:
 			_, err = strconv.ParseUint(attributes[i].GetValue(), 10, 64)
@@ -67,10 +65,10 @@ func (x *NodeInfo) readFromV2(m netmap.NodeInfo, checkFieldPresence bool) error
 			if err != nil {
 				return fmt.Errorf("invalid %s attribute: %w", attrPrice, err)
 			}
-		case strings.HasPrefix(key, subnetPrefix):
+		case strings.HasPrefix(key, subnetAttributePrefix):
 			var id subnetid.ID
 
-			err = id.DecodeString(strings.TrimPrefix(key, subnetPrefix))
+			err = id.DecodeString(strings.TrimPrefix(key, subnetAttributePrefix))
 			if err != nil {
 				return fmt.Errorf("invalid key to the subnet attribute %s: %w", key, err)
 			}
@@ -294,7 +292,16 @@ func (x NodeInfo) capacity() uint64 {
 	return capacity
 }
 
-const attrUNLOCODE = "UN-LOCODE"
+// Keys to the node location attributes.
+const (
+	attrUNLOCODE     = "UN-LOCODE"
+	attrCountryCode  = "CountryCode"
+	attrCountryName  = "Country"
+	attrLocationName = "Location"
+	attrSubDivCode   = "SubDivCode"
+	attrSubDivName   = "SubDiv"
+	attrContinent    = "Continent"
+)
 
 // SetLOCODE specifies node's geographic location in UN/LOCODE format. Each
 // storage node MUST declare it for entrance to the NeoFS network. Node MAY
@@ -321,7 +328,7 @@ func (x NodeInfo) LOCODE() string {
 // SetCountryCode is intended only for processing the network registration
 // request by the Inner Ring. Other parties SHOULD NOT use it.
 func (x *NodeInfo) SetCountryCode(countryCode string) {
-	x.SetAttribute("CountryCode", countryCode)
+	x.SetAttribute(attrCountryCode, countryCode)
 }
 
 // SetCountryName sets short name of the country in ISO-3166 format to which
@@ -330,7 +337,7 @@ func (x *NodeInfo) SetCountryCode(countryCode string) {
 // SetCountryName is intended only for processing the network registration
 // request by the Inner Ring. Other parties SHOULD NOT use it.
 func (x *NodeInfo) SetCountryName(country string) {
-	x.SetAttribute("Country", country)
+	x.SetAttribute(attrCountryName, country)
 }
 
 // SetLocationName sets storage node's location name from "NameWoDiacritics"
@@ -339,7 +346,7 @@ func (x *NodeInfo) SetCountryName(country string) {
 // SetLocationName is intended only for processing the network registration
 // request by the Inner Ring. Other parties SHOULD NOT use it.
 func (x *NodeInfo) SetLocationName(location string) {
-	x.SetAttribute("Location", location)
+	x.SetAttribute(attrLocationName, location)
 }
 
 // SetSubdivisionCode sets storage node's subdivision code from "SubDiv" column in
@@ -348,7 +355,7 @@ func (x *NodeInfo) SetLocationName(location string) {
 // SetSubdivisionCode is intended only for processing the network registration
 // request by the Inner Ring. Other parties SHOULD NOT use it.
 func (x *NodeInfo) SetSubdivisionCode(subDiv string) {
-	x.SetAttribute("SubDivCode", subDiv)
+	x.SetAttribute(attrSubDivCode, subDiv)
 }
 
 // SetSubdivisionName sets storage node's subdivision name in ISO 3166-2 format.
@@ -356,16 +363,17 @@ func (x *NodeInfo) SetSubdivisionCode(subDiv string) {
 // SetSubdivisionName is intended only for processing the network registration
 // request by the Inner Ring. Other parties SHOULD NOT use it.
 func (x *NodeInfo) SetSubdivisionName(subDiv string) {
-	x.SetAttribute("SubDiv", subDiv)
+	x.SetAttribute(attrSubDivName, subDiv)
 }
 
 // SetContinentName sets name of the storage node's continent from
-// Seven-Continent model.
+// Seven-Continent model. Words of the name are separated by underscores, e.g.
+// North_America.
 //
 // SetContinentName is intended only for processing the network registration
 // request by the Inner Ring. Other parties SHOULD NOT use it.
 func (x *NodeInfo) SetContinentName(continent string) {
-	x.SetAttribute("Continent", continent)
+	x.SetAttribute(attrContinent, continent)
 }
 
 // Enumeration of well-known attributes.
@@ -382,6 +390,9 @@ const (
 	attrExternalAddr = "ExternalAddr"
 	// sepExternalAddr is a separator for multi-value ExternalAddr attribute.
 	sepExternalAddr = ","
+
+	// subnetAttributePrefix is a prefix of the keys to the subnet attributes.
+	subnetAttributePrefix = "__NEOFS__SUBNET_"
 )
 
 // SetExternalAddresses sets multi-addresses to use
@@ -456,6 +467,17 @@ func (x NodeInfo) Attribute(key string) string {
 	return ""
 }
 
+// removeAttribute removes the node attribute by the given key if present.
+func (x *NodeInfo) removeAttribute(key string) {
+	a := x.m.GetAttributes()
+	for i := range a {
+		if a[i].GetKey() == key {
+			x.m.SetAttributes(append(a[:i:i], a[i+1:]...))
+			return
+		}
+	}
+}
+
 // SortAttributes sorts node attributes set using SetAttribute lexicographically.
 // The method is only needed to make NodeInfo consistent, e.g. for signing.
 func (x *NodeInfo) SortAttributes() {
@@ -4,7 +4,7 @@ options {
     tokenVocab = QueryLexer;
 }
 
-policy: repStmt+ cbfStmt? selectStmt* filterStmt* EOF;
+policy: UNIQUE? repStmt+ cbfStmt? selectStmt* filterStmt* EOF;
 
 repStmt:
     REP Count = NUMBER1     // number of object replicas
@@ -22,7 +22,8 @@ selectStmt:
 clause: CLAUSE_SAME | CLAUSE_DISTINCT; // nodes from distinct buckets
 
 filterExpr:
-    F1 = filterExpr Op = AND_OP F2 = filterExpr
+    Op = NOT_OP '(' F1 = filterExpr ')'
+    | F1 = filterExpr Op = AND_OP F2 = filterExpr
     | F1 = filterExpr Op = OR_OP F2 = filterExpr
     | '(' Inner = filterExpr ')'
     | expr
@@ -2,8 +2,10 @@ lexer grammar QueryLexer;
 
 AND_OP     : 'AND';
 OR_OP      : 'OR';
-SIMPLE_OP  : 'EQ' | 'NE' | 'GE' | 'GT' | 'LT' | 'LE';
+NOT_OP     : 'NOT';
+SIMPLE_OP  : 'EQ' | 'NE' | 'GE' | 'GT' | 'LT' | 'LE' | 'LIKE';
 
+UNIQUE   : 'UNIQUE';
 REP      : 'REP';
 IN       : 'IN';
 AS       : 'AS';
@@ -32,6 +32,8 @@ type PlacementPolicy struct {
 	selectors []netmap.Selector
 
 	replicas []netmap.Replica
+
+	unique bool
 }
 
 func (p *PlacementPolicy) readFromV2(m netmap.PlacementPolicy, checkFieldPresence bool) error {
@@ -53,6 +55,7 @@ func (p *PlacementPolicy) readFromV2(m netmap.PlacementPolicy, checkFieldPresenc
 	p.backupFactor = m.GetContainerBackupFactor()
 	p.selectors = m.GetSelectors()
 	p.filters = m.GetFilters()
+	p.unique = false
 
 	return nil
 }
@@ -121,6 +124,9 @@ func (p *PlacementPolicy) ReadFromV2(m netmap.PlacementPolicy) error {
 // WriteToV2 writes PlacementPolicy to the session.Token message.
 // The message must not be nil.
 //
+// Note that uniqueness of the replica nodes (see SetUnique) is not transmitted
+// since NeoFS API V2 protocol doesn't support it.
+//
 // See also ReadFromV2.
 func (p PlacementPolicy) WriteToV2(m *netmap.PlacementPolicy) {
 	var subnetV2 refs.SubnetID
@@ -146,6 +152,25 @@ func (p PlacementPolicy) Subnet() subnetid.ID {
 	return p.subnet
 }
 
+// SetUnique sets the rule to select distinct nodes for all replica
+// descriptors: node selected for one replica is never selected for another.
+// The rule is expressed as UNIQUE keyword in the human-readable query.
+//
+// The rule is not transmitted in the NeoFS API V2 protocol messages
+// (see WriteToV2).
+//
+// Zero PlacementPolicy doesn't require unique nodes.
+func (p *PlacementPolicy) SetUnique(b bool) {
+	p.unique = b
+}
+
+// IsUnique checks if the policy requires unique nodes for the replicas.
+//
+// See also SetUnique.
+func (p PlacementPolicy) IsUnique() bool {
+	return p.unique
+}
+
 // ReplicaDescriptor replica descriptor characterizes replicas of objects from
 // the subset selected by a particular Selector.
 type ReplicaDescriptor struct {
@@ -279,6 +304,13 @@ func (p *PlacementPolicy) AddSelectors(ss ...Selector) {
 	}
 }
 
+// Filter operations which are not enumerated in the NeoFS API V2 protocol yet.
+// Protocol enumerations are open, so the values are transmitted as is.
+const (
+	opNOT  netmap.Operation = 9
+	opLIKE netmap.Operation = 10
+)
+
 // Filter contains rules for filtering the node sets.
 type Filter struct {
 	m netmap.Filter
@@ -313,6 +345,15 @@ func (x *Filter) NotEqual(key, value string) {
 	x.setAttribute(key, netmap.NE, value)
 }
 
+// Like applies the rule to accept only nodes with the attribute value matching
+// the given pattern. Wildcard '*' in the pattern matches any (including empty)
+// sequence of characters, e.g. "Europe*" matches values starting with "Europe".
+//
+// Method SHOULD NOT be called along with other similar methods.
+func (x *Filter) Like(key, pattern string) {
+	x.setAttribute(key, opLIKE, pattern)
+}
+
 // NumericGT applies the rule to accept only nodes with the numeric attribute
 // greater than given number.
 //
@@ -376,6 +417,14 @@ func (x *Filter) LogicalAND(filters ...Filter) {
 	x.setInnerFilters(netmap.AND, filters)
 }
 
+// LogicalNOT applies the rule to accept only nodes which don't satisfy the
+// given filter.
+//
+// Method SHOULD NOT be called along with other similar methods.
+func (x *Filter) LogicalNOT(filter Filter) {
+	x.setInnerFilters(opNOT, []Filter{filter})
+}
+
 // AddFilters adds a Filter bunch that will be applied when selecting nodes.
 //
 // Zero PlacementPolicy has no filters.
@@ -407,6 +456,15 @@ func (p PlacementPolicy) WriteStringTo(w io.StringWriter) (err error) {
 		return nil
 	}
 
+	if p.unique {
+		writtenSmth = true
+
+		_, err = w.WriteString("UNIQUE")
+		if err != nil {
+			return err
+		}
+	}
+
 	for i := range p.replicas {
 		err = writeLnIfNeeded()
 		if err != nil {
@@ -512,7 +570,13 @@ func writeFilterStringTo(w io.StringWriter, f netmap.Filter) error {
 	unspecified := op == 0
 
 	if s = f.GetKey(); s != "" {
-		_, err = w.WriteString(fmt.Sprintf("%s %s %s", s, op, f.GetValue()))
+		val := f.GetValue()
+		if op == opLIKE {
+			// pattern wildcards are not allowed in identifiers
+			val = `"` + val + `"`
+		}
+
+		_, err = w.WriteString(fmt.Sprintf("%s %s %s", s, operationToString(op), val))
 		if err != nil {
 			return err
 		}
@@ -524,6 +588,14 @@ func writeFilterStringTo(w io.StringWriter, f netmap.Filter) error {
 	}
 
 	inner := f.GetFilters()
+
+	if op == opNOT {
+		_, err = w.WriteString("NOT (")
+		if err != nil {
+			return err
+		}
+	}
+
 	for i := range inner {
 		if i != 0 {
 			_, err = w.WriteString(" " + op.String() + " ")
@@ -538,6 +610,13 @@ func writeFilterStringTo(w io.StringWriter, f netmap.Filter) error {
 		}
 	}
 
+	if op == opNOT {
+		_, err = w.WriteString(")")
+		if err != nil {
+			return err
+		}
+	}
+
 	if s = f.GetName(); s != "" && !unspecified {
 		_, err = w.WriteString(" AS " + s)
 		if err != nil {
@@ -617,6 +696,8 @@ func (p *policyVisitor) VisitPolicy(ctx *parser.PolicyContext) interface{} {
 	}
 
 	pl := new(PlacementPolicy)
+	pl.unique = ctx.UNIQUE() != nil
+
 	repStmts := ctx.AllRepStmt()
 	pl.replicas = make([]netmap.Replica, 0, len(repStmts))
 
@@ -728,6 +809,14 @@ func (p *policyVisitor) VisitFilterExpr(ctx *parser.FilterExprContext) interface
 	}
 
 	f := new(netmap.Filter)
+
+	if ctx.NOT_OP() != nil {
+		f.SetOp(opNOT)
+		f.SetFilters([]netmap.Filter{*ctx.GetF1().Accept(p).(*netmap.Filter)})
+
+		return f
+	}
+
 	op := operationFromString(ctx.GetOp().GetText())
 	f.SetOp(op)
 
@@ -826,10 +915,28 @@ func clauseFromString(s string) (c netmap.Clause) {
 }
 
 func operationFromString(s string) (op netmap.Operation) {
-	if !op.FromString(strings.ToUpper(s)) {
+	switch s = strings.ToUpper(s); s {
+	case "NOT":
+		return opNOT
+	case "LIKE":
+		return opLIKE
+	}
+
+	if !op.FromString(s) {
 		// Such errors should be handled by ANTLR code thus this panic.
 		panic(fmt.Errorf("BUG: invalid operation: %s", op))
 	}
 
 	return
 }
+
+func operationToString(op netmap.Operation) string {
+	switch op {
+	case opNOT:
+		return "NOT"
+	case opLIKE:
+		return "LIKE"
+	default:
+		return op.String()
+	}
+}
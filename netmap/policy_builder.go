@@ -0,0 +1,217 @@
+package netmap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	subnetid "github.com/nspcc-dev/neofs-sdk-go/subnet/id"
+)
+
+// ReplicaIntent describes where copies of the container objects should be
+// stored. Each ReplicaIntent results in one replica descriptor with its own
+// selector in the PlacementPolicy built by PolicyBuilder.
+//
+// Instances can be created using built-in var declaration.
+type ReplicaIntent struct {
+	copies uint32
+
+	spread string
+
+	filter Filter
+
+	filtered bool
+}
+
+// SetCopies sets number of the object copies. Number MUST be positive.
+func (x *ReplicaIntent) SetCopies(n uint32) {
+	x.copies = n
+}
+
+// SpreadAcross requires copies to be stored on the nodes with distinct values
+// of the given attribute, e.g. one copy per "Country". By default, copies are
+// stored on any distinct nodes.
+func (x *ReplicaIntent) SpreadAcross(attribute string) {
+	x.spread = attribute
+}
+
+// RestrictTo requires copies to be stored on the nodes passing the given
+// Filter only. Unnamed filter is named by PolicyBuilder. Filter MUST NOT
+// reference other named filters.
+func (x *ReplicaIntent) RestrictTo(f Filter) {
+	x.filter = f
+	x.filtered = true
+}
+
+// PolicyBuilder builds PlacementPolicy from the storage intents, for example,
+// "3 copies spread across distinct countries from Europe". See Build.
+//
+// Instances can be created using built-in var declaration.
+type PolicyBuilder struct {
+	intents []ReplicaIntent
+
+	subnet subnetid.ID
+
+	cbf uint32
+
+	unique bool
+
+	nm *NetMap
+}
+
+// AddIntents adds storage intents. Intents are converted into the replica
+// descriptors in the order of addition.
+func (x *PolicyBuilder) AddIntents(is ...ReplicaIntent) {
+	x.intents = append(x.intents, is...)
+}
+
+// RestrictSubnet sets subnet of the container nodes (see
+// PlacementPolicy.RestrictSubnet). Zero subnet is used by default.
+func (x *PolicyBuilder) RestrictSubnet(id subnetid.ID) {
+	x.subnet = id
+}
+
+// SetContainerBackupFactor sets container backup factor (see
+// PlacementPolicy.SetContainerBackupFactor). Factor is not declared by
+// default.
+func (x *PolicyBuilder) SetContainerBackupFactor(f uint32) {
+	x.cbf = f
+}
+
+// SetUnique requires copies of the different intents to be stored on
+// the different nodes (see PlacementPolicy.SetUnique).
+func (x *PolicyBuilder) SetUnique(b bool) {
+	x.unique = b
+}
+
+// ValidateAgainst makes Build to check that the built policy can be applied to
+// the given NetMap.
+func (x *PolicyBuilder) ValidateAgainst(nm NetMap) {
+	x.nm = &nm
+}
+
+// Build returns PlacementPolicy fulfilling all added intents along with its
+// canonical string form (see PlacementPolicy.WriteStringTo). Selectors of
+// the intents are named "S1", "S2", etc. in order, unnamed filters - "F1",
+// "F2", etc. after the intent. Note that string form doesn't include
+// the subnet.
+//
+// Returns an error if the intents are incorrect or, when a NetMap is given via
+// ValidateAgainst, if the policy can't be applied to it.
+func (x PolicyBuilder) Build() (PlacementPolicy, string, error) {
+	var res PlacementPolicy
+
+	if len(x.intents) == 0 {
+		return res, "", errors.New("no intents")
+	}
+
+	filters := make(map[string]string)
+
+	for i, in := range x.intents {
+		n := strconv.Itoa(i + 1)
+
+		if in.copies == 0 {
+			return res, "", fmt.Errorf("intent #%d: zero number of copies", i)
+		}
+
+		var s Selector
+		s.SetName("S" + n)
+		s.SetNumberOfNodes(in.copies)
+		s.SetFilterName(mainFilterName)
+
+		if in.spread != "" {
+			s.SelectByBucketAttribute(in.spread)
+			s.SelectDistinct()
+		}
+
+		if in.filtered {
+			f := in.filter
+
+			if f.m.GetName() == "" {
+				f.SetName("F" + n)
+			}
+
+			var sb strings.Builder
+
+			err := writeFilterStringTo(&sb, f.m)
+			if err != nil {
+				return res, "", fmt.Errorf("intent #%d: encode filter: %w", i, err)
+			}
+
+			name := f.m.GetName()
+
+			if prev, ok := filters[name]; !ok {
+				filters[name] = sb.String()
+				res.AddFilters(f)
+			} else if prev != sb.String() {
+				return res, "", fmt.Errorf("intent #%d: different filters with the same name '%s'", i, name)
+			}
+
+			s.SetFilterName(name)
+		}
+
+		var r ReplicaDescriptor
+		r.SetNumberOfObjects(in.copies)
+		r.SetSelectorName("S" + n)
+
+		res.AddSelectors(s)
+		res.AddReplicas(r)
+	}
+
+	res.RestrictSubnet(x.subnet)
+	res.SetContainerBackupFactor(x.cbf)
+	res.SetUnique(x.unique)
+
+	var sb strings.Builder
+
+	err := res.WriteStringTo(&sb)
+	if err != nil {
+		return res, "", fmt.Errorf("encode policy: %w", err)
+	}
+
+	// make sure the string form is correct
+	var check PlacementPolicy
+
+	err = check.DecodeString(sb.String())
+	if err != nil {
+		return res, "", fmt.Errorf("invalid policy: %w", err)
+	}
+
+	if x.nm != nil {
+		err = checkPolicyFeasibility(*x.nm, res)
+		if err != nil {
+			return res, "", err
+		}
+	}
+
+	return res, sb.String(), nil
+}
+
+// checkPolicyFeasibility checks that the policy can be applied to the NetMap.
+// Selectors are analyzed for the error details, while the whole policy is
+// checked by the single placement.
+func checkPolicyFeasibility(nm NetMap, p PlacementPolicy) error {
+	rep, err := nm.analyzeSelection(p, nil, nil)
+	if err != nil {
+		return fmt.Errorf("invalid policy: %w", err)
+	}
+
+	for _, s := range rep.Selectors() {
+		if !s.Satisfiable() {
+			return fmt.Errorf("selector %s can't be applied to the network map: %w", s.Name(), s.Err())
+		}
+	}
+
+	for i, r := range rep.Replicas() {
+		if !r.Selector().Satisfiable() {
+			return fmt.Errorf("replica #%d can't be placed in the network map: %w", i, r.Selector().Err())
+		}
+	}
+
+	if _, err = nm.ContainerNodes(p, nil); err != nil {
+		return fmt.Errorf("policy can't be applied to the network map: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package netmap
+
+import (
+	"strconv"
+	"testing"
+
+	subnetid "github.com/nspcc-dev/neofs-sdk-go/subnet/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyBuilder_Build(t *testing.T) {
+	var b PolicyBuilder
+
+	_, _, err := b.Build()
+	require.Error(t, err)
+
+	var eu Filter
+	eu.Equal("Continent", "Europe")
+
+	var spread ReplicaIntent
+	spread.SetCopies(3)
+	spread.SpreadAcross("Country")
+	spread.RestrictTo(eu)
+
+	var single ReplicaIntent
+	single.SetCopies(1)
+
+	b.AddIntents(spread, single)
+	b.SetContainerBackupFactor(2)
+
+	p, s, err := b.Build()
+	require.NoError(t, err)
+	require.Equal(t, `REP 3 IN S1
+REP 1 IN S2
+CBF 2
+SELECT 3 IN DISTINCT Country FROM F1 AS S1
+SELECT 1 FROM * AS S2
+FILTER Continent EQ Europe AS F1`, s)
+
+	var exp PlacementPolicy
+	require.NoError(t, exp.DecodeString(s))
+	require.Equal(t, exp, p)
+
+	var id subnetid.ID
+	id.SetNumeric(5)
+
+	b.RestrictSubnet(id)
+	b.SetUnique(true)
+
+	p, s, err = b.Build()
+	require.NoError(t, err)
+	require.True(t, p.Subnet().Equals(id))
+	require.True(t, p.IsUnique())
+	require.Equal(t, "UNIQUE\n", s[:7])
+
+	var zero ReplicaIntent
+	b.AddIntents(zero)
+
+	_, _, err = b.Build()
+	require.Error(t, err)
+}
+
+func TestPolicyBuilder_NamedFilters(t *testing.T) {
+	var eu Filter
+	eu.SetName("EU")
+	eu.Equal("Continent", "Europe")
+
+	var in ReplicaIntent
+	in.SetCopies(1)
+	in.RestrictTo(eu)
+
+	var b PolicyBuilder
+	b.AddIntents(in, in)
+
+	_, s, err := b.Build()
+	require.NoError(t, err)
+	require.Equal(t, `REP 1 IN S1
+REP 1 IN S2
+SELECT 1 FROM EU AS S1
+SELECT 1 FROM EU AS S2
+FILTER Continent EQ Europe AS EU`, s)
+
+	var other Filter
+	other.SetName("EU")
+	other.Equal("Continent", "Asia")
+
+	in.RestrictTo(other)
+	b.AddIntents(in)
+
+	_, _, err = b.Build()
+	require.Error(t, err)
+}
+
+func TestPolicyBuilder_ValidateAgainst(t *testing.T) {
+	// 2 countries in Europe, 1 in Asia
+	ns := nodeInfosFromAttributes(6, func(i int) []string {
+		continent := "Europe"
+		if i >= 4 {
+			continent = "Asia"
+		}
+
+		return []string{"Continent", continent, "Country", strconv.Itoa(i / 2), "Price", "1", "Capacity", "10"}
+	})
+
+	var nm NetMap
+	nm.SetNodes(ns)
+
+	var eu Filter
+	eu.Equal("Continent", "Europe")
+
+	var in ReplicaIntent
+	in.SetCopies(2)
+	in.SpreadAcross("Country")
+	in.RestrictTo(eu)
+
+	var b PolicyBuilder
+	b.AddIntents(in)
+	b.SetContainerBackupFactor(1)
+	b.ValidateAgainst(nm)
+
+	_, _, err := b.Build()
+	require.NoError(t, err)
+
+	in.SetCopies(3)
+
+	b = PolicyBuilder{}
+	b.AddIntents(in)
+	b.SetContainerBackupFactor(1)
+
+	_, _, err = b.Build()
+	require.NoError(t, err)
+
+	b.ValidateAgainst(nm)
+
+	_, _, err = b.Build()
+	require.ErrorIs(t, err, errNotEnoughNodes)
+
+	// each intent fits Europe, but not together on the unique nodes
+	var in1, in2 ReplicaIntent
+	in1.SetCopies(3)
+	in1.RestrictTo(eu)
+	in2.SetCopies(2)
+	in2.RestrictTo(eu)
+
+	b = PolicyBuilder{}
+	b.AddIntents(in1, in2)
+	b.SetContainerBackupFactor(1)
+	b.ValidateAgainst(nm)
+
+	_, _, err = b.Build()
+	require.NoError(t, err)
+
+	b.SetUnique(true)
+
+	_, _, err = b.Build()
+	require.Error(t, err)
+}
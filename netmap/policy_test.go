@@ -23,6 +23,20 @@ FILTER @FromRU AND Rating GT 7 AS Good`,
 		`REP 7 IN SPB
 SELECT 1 IN City FROM SPBSSD AS SPB
 FILTER City EQ SPB AND SSD EQ true OR City EQ SPB AND Rating GE 5 AS SPBSSD`,
+
+		`UNIQUE
+REP 1 IN X
+REP 2 IN X
+SELECT 3 FROM * AS X`,
+
+		`REP 1
+SELECT 1 FROM F
+FILTER Country EQ RU AS RU
+FILTER NOT (@RU) AND Location LIKE "Europe*" AS F`,
+
+		`REP 1
+SELECT 1 FROM F
+FILTER NOT (Country EQ RU OR Country EQ DE) AS F`,
 	}
 
 	var p PlacementPolicy
@@ -40,6 +54,8 @@ FILTER City EQ SPB AND SSD EQ true OR City EQ SPB AND Rating GE 5 AS SPBSSD`,
 	invalidTestCases := []string{
 		`?REP 1`,
 		`REP 1 trailing garbage`,
+		`REP 1 UNIQUE`,
+		`REP 1 FILTER NOT @A AS F`,
 	}
 
 	for i := range invalidTestCases {
@@ -67,3 +83,37 @@ func TestPlacementPolicyEncoding(t *testing.T) {
 		require.Equal(t, v, v2)
 	})
 }
+
+func TestPlacementPolicy_SetUnique(t *testing.T) {
+	var p PlacementPolicy
+	require.False(t, p.IsUnique())
+
+	p.SetUnique(true)
+	require.True(t, p.IsUnique())
+
+	// not supported by NeoFS API V2
+	var p2 PlacementPolicy
+	require.NoError(t, p2.Unmarshal(p.Marshal()))
+	require.False(t, p2.IsUnique())
+}
+
+func TestFilterEncoding(t *testing.T) {
+	var like, not Filter
+	like.Like("Location", "Europe*")
+	not.LogicalNOT(like)
+	not.SetName("F")
+
+	var p PlacementPolicy
+	p.AddFilters(not)
+
+	var p2 PlacementPolicy
+	require.NoError(t, p2.Unmarshal(p.Marshal()))
+	require.Equal(t, p, p2)
+
+	data, err := p.MarshalJSON()
+	require.NoError(t, err)
+
+	p2 = PlacementPolicy{}
+	require.NoError(t, p2.UnmarshalJSON(data))
+	require.Equal(t, p, p2)
+}
@@ -0,0 +1,335 @@
+package netmap
+
+import (
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+
+	subnetid "github.com/nspcc-dev/neofs-sdk-go/subnet/id"
+)
+
+// Grouping returns names of the groups the node belongs to in NetMap.Report.
+// Node may belong to several groups or to none of them.
+type Grouping func(NodeInfo) []string
+
+// GroupByAttribute returns Grouping by value of the node attribute with
+// the given key, e.g. "Country" or "Continent". Nodes without the attribute
+// are grouped under the empty name.
+func GroupByAttribute(key string) Grouping {
+	return func(n NodeInfo) []string {
+		return []string{n.Attribute(key)}
+	}
+}
+
+// GroupBySubnet returns Grouping by the subnets the nodes belong to. Groups are
+// named by the string form of the subnet identifiers (see subnetid.ID.String).
+// Nodes with malformed subnet attributes are not grouped.
+func GroupBySubnet() Grouping {
+	return func(n NodeInfo) []string {
+		var res []string
+
+		err := n.IterateSubnets(func(id subnetid.ID) error {
+			res = append(res, id.String())
+			return nil
+		})
+		if err != nil {
+			return nil
+		}
+
+		return res
+	}
+}
+
+// PriceStat groups statistics of the node prices. Nodes with missing or
+// malformed price are not taken into account.
+type PriceStat struct {
+	// Number of the nodes with valid price.
+	Nodes int `json:"nodes"`
+
+	// Minimum and maximum price.
+	Min uint64 `json:"min"`
+	Max uint64 `json:"max"`
+
+	// Mean and median price.
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+}
+
+// GroupReport describes group of the nodes. Capacities are measured in GB.
+type GroupReport struct {
+	// Name of the group. Empty for the report of the whole NetMap.
+	Name string `json:"name"`
+
+	// Number of the nodes in the group.
+	Nodes int `json:"nodes"`
+
+	// Numbers of the nodes by state.
+	Online      int `json:"online"`
+	Offline     int `json:"offline"`
+	Maintenance int `json:"maintenance"`
+
+	// Sum of the capacities declared by the nodes. Nodes with missing or
+	// malformed capacity are not taken into account.
+	TotalCapacity uint64 `json:"totalCapacity"`
+
+	// Sum of the used space of the nodes (see ReportPrm.SetUsedSpace).
+	UsedCapacity uint64 `json:"usedCapacity"`
+
+	// Difference between total and used capacity of each node summed up.
+	// Nodes with unknown used space are considered empty.
+	FreeCapacity uint64 `json:"freeCapacity"`
+
+	// Number of the nodes with unknown used space.
+	NodesWithoutUsage int `json:"nodesWithoutUsage"`
+
+	// Statistics of the node prices.
+	Price PriceStat `json:"price"`
+}
+
+// IssueKind is a kind of the problem with the node attribute.
+type IssueKind string
+
+// Kinds of the node attribute problems.
+const (
+	// IssueMissing is a kind of required attribute absence.
+	IssueMissing IssueKind = "missing"
+
+	// IssueMalformed is a kind of attribute value format violation.
+	IssueMalformed IssueKind = "malformed"
+
+	// IssueInconsistent is a kind of the attribute value contradicting other
+	// attributes.
+	IssueInconsistent IssueKind = "inconsistent"
+)
+
+// AttributeIssue describes problem with the standard attribute of the node.
+type AttributeIssue struct {
+	// Hex-encoded public key of the node.
+	PublicKey string `json:"publicKey"`
+
+	// Key to the attribute.
+	Attribute string `json:"attribute"`
+
+	// Value of the attribute, empty if the attribute is missing.
+	Value string `json:"value,omitempty"`
+
+	// Kind of the problem.
+	Kind IssueKind `json:"kind"`
+}
+
+// NetMapReport is an aggregate view of the NetMap. Instances are created by
+// NetMap.Report and can be encoded into JSON using encoding/json package.
+type NetMapReport struct {
+	// Epoch of the NetMap.
+	Epoch uint64 `json:"epoch"`
+
+	// Report of the whole NetMap.
+	Total GroupReport `json:"total"`
+
+	// Reports of the node groups sorted by name. Empty if grouping is not
+	// specified.
+	Groups []GroupReport `json:"groups,omitempty"`
+
+	// Problems with the standard attributes in the order of the nodes.
+	Issues []AttributeIssue `json:"issues,omitempty"`
+}
+
+// ReportPrm groups optional parameters of NetMap.Report.
+type ReportPrm struct {
+	grouping Grouping
+
+	usedSpace func(NodeInfo) (uint64, bool)
+}
+
+// SetGrouping sets Grouping of the nodes. Nodes are not grouped by default.
+func (x *ReportPrm) SetGrouping(g Grouping) {
+	x.grouping = g
+}
+
+// SetUsedSpace sets function returning used space of the node in GB. Nodes
+// don't announce used space in the NetMap, so it should be collected
+// separately. Function returns false if used space of the node is unknown.
+// Used space of all nodes is unknown by default.
+func (x *ReportPrm) SetUsedSpace(f func(NodeInfo) (used uint64, ok bool)) {
+	x.usedSpace = f
+}
+
+// groupAcc accumulates GroupReport.
+type groupAcc struct {
+	GroupReport
+
+	prices []uint64
+}
+
+func (x *groupAcc) add(n NodeInfo, prm ReportPrm) {
+	x.Nodes++
+
+	switch {
+	case n.IsOnline():
+		x.Online++
+	case n.IsOffline():
+		x.Offline++
+	case n.IsMaintenance():
+		x.Maintenance++
+	}
+
+	capacity, _ := strconv.ParseUint(n.Attribute(attrCapacity), 10, 64)
+	x.TotalCapacity += capacity
+
+	var (
+		used uint64
+		ok   bool
+	)
+
+	if prm.usedSpace != nil {
+		used, ok = prm.usedSpace(n)
+	}
+
+	if ok {
+		x.UsedCapacity += used
+	} else {
+		x.NodesWithoutUsage++
+	}
+
+	if used < capacity {
+		x.FreeCapacity += capacity - used
+	}
+
+	if price, err := strconv.ParseUint(n.Attribute(attrPrice), 10, 64); err == nil {
+		x.prices = append(x.prices, price)
+	}
+}
+
+func (x *groupAcc) report() GroupReport {
+	res := x.GroupReport
+
+	l := len(x.prices)
+	if l == 0 {
+		return res
+	}
+
+	sort.Slice(x.prices, func(i, j int) bool { return x.prices[i] < x.prices[j] })
+
+	var sum float64
+	for i := range x.prices {
+		sum += float64(x.prices[i])
+	}
+
+	res.Price.Nodes = l
+	res.Price.Min = x.prices[0]
+	res.Price.Max = x.prices[l-1]
+	res.Price.Mean = sum / float64(l)
+
+	if l%2 == 1 {
+		res.Price.Median = float64(x.prices[l/2])
+	} else {
+		res.Price.Median = (float64(x.prices[l/2-1]) + float64(x.prices[l/2])) / 2
+	}
+
+	return res
+}
+
+// Report calculates aggregate view of the NetMap: numbers of the nodes,
+// capacities and price statistics for the whole NetMap and for each group of
+// the nodes, and problems with the standard node attributes.
+func (m NetMap) Report(prm ReportPrm) NetMapReport {
+	res := NetMapReport{
+		Epoch: m.epoch,
+	}
+
+	var total groupAcc
+
+	groups := make(map[string]*groupAcc)
+
+	for i := range m.nodes {
+		total.add(m.nodes[i], prm)
+
+		if prm.grouping != nil {
+			for _, name := range prm.grouping(m.nodes[i]) {
+				g, ok := groups[name]
+				if !ok {
+					g = &groupAcc{GroupReport: GroupReport{Name: name}}
+					groups[name] = g
+				}
+
+				g.add(m.nodes[i], prm)
+			}
+		}
+
+		res.Issues = append(res.Issues, checkAttributes(m.nodes[i])...)
+	}
+
+	res.Total = total.report()
+
+	if len(groups) != 0 {
+		res.Groups = make([]GroupReport, 0, len(groups))
+
+		for _, g := range groups {
+			res.Groups = append(res.Groups, g.report())
+		}
+
+		sort.Slice(res.Groups, func(i, j int) bool { return res.Groups[i].Name < res.Groups[j].Name })
+	}
+
+	return res
+}
+
+// checkAttributes returns problems with the standard attributes of the node.
+func checkAttributes(n NodeInfo) []AttributeIssue {
+	var res []AttributeIssue
+
+	pub := hex.EncodeToString(n.PublicKey())
+
+	issue := func(key, val string, kind IssueKind) {
+		res = append(res, AttributeIssue{
+			PublicKey: pub,
+			Attribute: key,
+			Value:     val,
+			Kind:      kind,
+		})
+	}
+
+	for _, key := range []string{attrPrice, attrCapacity} {
+		val := n.Attribute(key)
+		if val == "" {
+			issue(key, "", IssueMissing)
+		} else if _, err := strconv.ParseUint(val, 10, 64); err != nil {
+			issue(key, val, IssueMalformed)
+		}
+	}
+
+	locode := n.LOCODE()
+	validLOCODE := isValidLOCODE(locode)
+
+	if locode == "" {
+		issue(attrUNLOCODE, "", IssueMissing)
+	} else if !validLOCODE {
+		issue(attrUNLOCODE, locode, IssueMalformed)
+	}
+
+	if country := n.Attribute(attrCountryCode); country != "" {
+		if len(country) != 2 || !isUpperAlphaNum(country) {
+			issue(attrCountryCode, country, IssueMalformed)
+		} else if validLOCODE && country != locode[:2] {
+			issue(attrCountryCode, country, IssueInconsistent)
+		}
+	}
+
+	if err := n.IterateSubnets(func(subnetid.ID) error { return nil }); err != nil {
+		issue(subnetAttributePrefix, "", IssueMalformed)
+	}
+
+	return res
+}
+
+// isValidLOCODE checks if s is a LOCODE in "CC LLL" format.
+func isValidLOCODE(s string) bool {
+	return len(s) == 6 && s[2] == ' ' && isUpperAlphaNum(s[:2]) && isUpperAlphaNum(s[3:])
+}
+
+func isUpperAlphaNum(s string) bool {
+	return strings.IndexFunc(s, func(r rune) bool {
+		return (r < 'A' || r > 'Z') && (r < '0' || r > '9')
+	}) < 0
+}
@@ -0,0 +1,132 @@
+package netmap
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	subnetid "github.com/nspcc-dev/neofs-sdk-go/subnet/id"
+	"github.com/stretchr/testify/require"
+)
+
+func newReportNetMap() (nm NetMap) {
+	ns := []NodeInfo{
+		nodeInfoFromAttributes("Country", "A", "Price", "1", "Capacity", "100", "UN-LOCODE", "RU MOW", "CountryCode", "RU"),
+		nodeInfoFromAttributes("Country", "A", "Price", "3", "Capacity", "200", "UN-LOCODE", "RU LED", "CountryCode", "DE"),
+		nodeInfoFromAttributes("Country", "B", "Price", "x", "UN-LOCODE", "bad", "__NEOFS__SUBNET_x", "True"),
+		nodeInfoFromAttributes("Price", "5", "Capacity", "50"),
+	}
+
+	ns[0].SetOnline()
+	ns[1].SetOffline()
+	ns[2].SetMaintenance()
+	ns[3].SetOnline()
+
+	var id subnetid.ID
+	id.SetNumeric(1)
+	ns[3].EnterSubnet(id)
+
+	for i := range ns {
+		ns[i].SetPublicKey([]byte{byte(i)})
+	}
+
+	nm.SetEpoch(13)
+	nm.SetNodes(ns)
+
+	return nm
+}
+
+func TestNetMap_Report(t *testing.T) {
+	nm := newReportNetMap()
+
+	var prm ReportPrm
+	prm.SetUsedSpace(func(n NodeInfo) (uint64, bool) {
+		switch n.PublicKey()[0] {
+		case 0:
+			return 30, true
+		case 1:
+			// over capacity
+			return 250, true
+		default:
+			return 0, false
+		}
+	})
+
+	res := nm.Report(prm)
+	require.EqualValues(t, 13, res.Epoch)
+	require.Empty(t, res.Groups)
+	require.Equal(t, GroupReport{
+		Nodes:             4,
+		Online:            2,
+		Offline:           1,
+		Maintenance:       1,
+		TotalCapacity:     350,
+		UsedCapacity:      280,
+		FreeCapacity:      120,
+		NodesWithoutUsage: 2,
+		Price: PriceStat{
+			Nodes:  3,
+			Min:    1,
+			Max:    5,
+			Mean:   3,
+			Median: 3,
+		},
+	}, res.Total)
+
+	key := func(i byte) string { return hex.EncodeToString([]byte{i}) }
+
+	require.Equal(t, []AttributeIssue{
+		{PublicKey: key(1), Attribute: "CountryCode", Value: "DE", Kind: IssueInconsistent},
+		{PublicKey: key(2), Attribute: "Price", Value: "x", Kind: IssueMalformed},
+		{PublicKey: key(2), Attribute: "Capacity", Kind: IssueMissing},
+		{PublicKey: key(2), Attribute: "UN-LOCODE", Value: "bad", Kind: IssueMalformed},
+		{PublicKey: key(2), Attribute: "__NEOFS__SUBNET_", Kind: IssueMalformed},
+		{PublicKey: key(3), Attribute: "UN-LOCODE", Kind: IssueMissing},
+	}, res.Issues)
+
+	prm.SetGrouping(GroupByAttribute("Country"))
+
+	res = nm.Report(prm)
+	require.Len(t, res.Groups, 3)
+
+	require.Equal(t, "", res.Groups[0].Name)
+	require.Equal(t, 1, res.Groups[0].Nodes)
+
+	a := res.Groups[1]
+	require.Equal(t, "A", a.Name)
+	require.Equal(t, 2, a.Nodes)
+	require.EqualValues(t, 300, a.TotalCapacity)
+	require.EqualValues(t, 70, a.FreeCapacity)
+	require.Equal(t, 2.0, a.Price.Median)
+
+	b := res.Groups[2]
+	require.Equal(t, "B", b.Name)
+	require.Zero(t, b.TotalCapacity)
+	require.Zero(t, b.Price.Nodes)
+
+	data, err := json.Marshal(res)
+	require.NoError(t, err)
+
+	var res2 NetMapReport
+	require.NoError(t, json.Unmarshal(data, &res2))
+	require.Equal(t, res, res2)
+}
+
+func TestGroupBySubnet(t *testing.T) {
+	nm := newReportNetMap()
+
+	var prm ReportPrm
+	prm.SetGrouping(GroupBySubnet())
+
+	res := nm.Report(prm)
+
+	var zero, one subnetid.ID
+	one.SetNumeric(1)
+
+	require.Len(t, res.Groups, 2)
+	require.Equal(t, zero.String(), res.Groups[0].Name)
+	// node with malformed subnets is not grouped
+	require.Equal(t, 3, res.Groups[0].Nodes)
+	require.Equal(t, one.String(), res.Groups[1].Name)
+	require.Equal(t, 1, res.Groups[1].Nodes)
+}
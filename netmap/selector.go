@@ -47,7 +47,7 @@ func calcNodesCount(s netmap.Selector) (int, int) {
 }
 
 // calcBucketWeight computes weight for a node bucket.
-func calcBucketWeight(ns nodes, a aggregator, wf weightFunc) float64 {
+func calcBucketWeight(ns nodes, a Aggregator, wf WeightFunc) float64 {
 	for i := range ns {
 		a.Add(wf(ns[i]))
 	}
@@ -105,7 +105,7 @@ func (c *context) getSelection(p PlacementPolicy, s netmap.Selector) ([]nodes, e
 	if len(c.hrwSeed) != 0 {
 		weights := make([]float64, len(res))
 		for i := range res {
-			weights[i] = calcBucketWeight(res[i], newMeanIQRAgg(), c.weightFunc)
+			weights[i] = calcBucketWeight(res[i], c.newBucketAgg(), c.weightFunc)
 		}
 
 		hrw.SortSliceByWeightValue(res, weights, c.hrwSeedHash)
@@ -145,6 +145,9 @@ func (c *context) getSelectionBase(subnetID subnetid.ID, s netmap.Selector) []no
 		if !BelongsToSubnet(c.netMap.nodes[i], subnetID) {
 			continue
 		}
+		if _, used := c.usedNodes[c.netMap.nodes[i].Hash()]; used {
+			continue
+		}
 		if isMain || c.match(f, c.netMap.nodes[i]) {
 			if attr == "" {
 				// Default attribute is transparent identifier which is different for every node.
@@ -194,6 +194,52 @@ func TestPlacementPolicy_DeterministicOrder(t *testing.T) {
 	}
 }
 
+func TestPlacementPolicy_ContainerNodesUnique(t *testing.T) {
+	nodeList := nodeInfosFromAttributes(6, func(i int) []string {
+		return []string{"Country", strconv.Itoa(i % 3), "Price", "1", "Capacity", "10"}
+	})
+
+	var nm NetMap
+	nm.SetNodes(nodeList)
+
+	var p PlacementPolicy
+	require.NoError(t, p.DecodeString(`REP 1 IN X
+REP 1 IN X
+CBF 1
+SELECT 2 IN DISTINCT Country FROM * AS X`))
+
+	v, err := nm.ContainerNodes(p, []byte{1})
+	require.NoError(t, err)
+	require.Equal(t, v[0], v[1])
+
+	p.SetUnique(true)
+
+	v, err = nm.ContainerNodes(p, []byte{1})
+	require.NoError(t, err)
+	require.Len(t, v[0], 2)
+	require.Len(t, v[1], 2)
+
+	seen := make(map[uint64]struct{})
+	for i := range v {
+		for j := range v[i] {
+			_, ok := seen[v[i][j].Hash()]
+			require.False(t, ok)
+			seen[v[i][j].Hash()] = struct{}{}
+		}
+	}
+
+	require.NoError(t, p.DecodeString(`UNIQUE
+REP 1 IN X
+REP 1 IN X
+REP 1 IN X
+REP 1 IN X
+CBF 1
+SELECT 2 IN DISTINCT Country FROM * AS X`))
+
+	_, err = nm.ContainerNodes(p, []byte{1})
+	require.ErrorIs(t, err, errNotEnoughNodes)
+}
+
 func TestPlacementPolicy_ProcessSelectors(t *testing.T) {
 	p := newPlacementPolicy(2, nil,
 		[]Selector{
@@ -0,0 +1,24 @@
+/*
+Package snapshot provides storage of the NeoFS network maps on disk.
+
+Store keeps network maps (e.g. results of client.Client.NetMapSnapshot) in
+the local directory, one file per epoch, in the binary format of the NeoFS API
+protocol (see netmap.NetMap.Marshal). Stored network maps allow to replay the
+placement offline, for example, during incident analysis.
+
+Save current network map:
+
+	st, err := snapshot.NewStore("/var/lib/neofs/netmaps")
+	// ...
+
+	err = st.Put(nm)
+	// ...
+
+Find where the object was placed at the particular epoch:
+
+	vectors, err := st.PlaceObject(epoch, policy, cnrID, objID)
+	if errors.Is(err, snapshot.ErrNotFound) {
+		// network map of the epoch was not captured
+	}
+*/
+package snapshot
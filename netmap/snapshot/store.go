@@ -0,0 +1,166 @@
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// ErrNotFound is returned when network map of the requested epoch is missing
+// in the Store.
+var ErrNotFound = errors.New("network map snapshot not found")
+
+// fileExt is an extension of the network map files.
+const fileExt = ".netmap"
+
+// Store keeps network maps on disk, one per epoch. Store is safe for
+// concurrent use, but the directory MUST NOT be shared between the stores
+// writing the same epochs.
+//
+// Instances are created by NewStore.
+type Store struct {
+	dir string
+}
+
+// NewStore returns Store keeping network maps in the given directory.
+// Directory is created if missing.
+func NewStore(dir string) (*Store, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("create directory: %w", err)
+	}
+
+	return &Store{
+		dir: dir,
+	}, nil
+}
+
+func (x *Store) path(epoch uint64) string {
+	return filepath.Join(x.dir, strconv.FormatUint(epoch, 10)+fileExt)
+}
+
+// Put saves the network map. Network map of the same epoch is overwritten.
+// Write is atomic: concurrent Get returns either previous or new network map.
+func (x *Store) Put(nm netmap.NetMap) error {
+	f, err := os.CreateTemp(x.dir, ".snapshot-*")
+	if err != nil {
+		return fmt.Errorf("create temporary file: %w", err)
+	}
+
+	_, err = f.Write(nm.Marshal())
+	if err == nil {
+		err = f.Sync()
+	}
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(f.Name(), x.path(nm.Epoch()))
+	}
+
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return fmt.Errorf("write network map of epoch %d: %w", nm.Epoch(), err)
+	}
+
+	return nil
+}
+
+// Get reads network map of the given epoch. Returns ErrNotFound if
+// the network map is missing.
+func (x *Store) Get(epoch uint64) (netmap.NetMap, error) {
+	var res netmap.NetMap
+
+	data, err := os.ReadFile(x.path(epoch))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return res, fmt.Errorf("%w: epoch %d", ErrNotFound, epoch)
+		}
+
+		return res, fmt.Errorf("read network map of epoch %d: %w", epoch, err)
+	}
+
+	err = res.Unmarshal(data)
+	if err != nil {
+		return res, fmt.Errorf("decode network map of epoch %d: %w", epoch, err)
+	}
+
+	if res.Epoch() != epoch {
+		return res, fmt.Errorf("file of epoch %d contains network map of epoch %d", epoch, res.Epoch())
+	}
+
+	return res, nil
+}
+
+// Epochs returns epochs of the stored network maps in ascending order.
+func (x *Store) Epochs() ([]uint64, error) {
+	entries, err := os.ReadDir(x.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read directory: %w", err)
+	}
+
+	var res []uint64
+
+	for i := range entries {
+		name := entries[i].Name()
+		if entries[i].IsDir() || !strings.HasSuffix(name, fileExt) {
+			continue
+		}
+
+		epoch, err := strconv.ParseUint(strings.TrimSuffix(name, fileExt), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		res = append(res, epoch)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+
+	return res, nil
+}
+
+// Prune removes network maps of the epochs less than the given one.
+func (x *Store) Prune(minEpoch uint64) error {
+	epochs, err := x.Epochs()
+	if err != nil {
+		return err
+	}
+
+	for i := range epochs {
+		if epochs[i] >= minEpoch {
+			break
+		}
+
+		err = os.Remove(x.path(epochs[i]))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("remove network map of epoch %d: %w", epochs[i], err)
+		}
+	}
+
+	return nil
+}
+
+// PlaceObject replays placement of the object at the given epoch: applies
+// the container PlacementPolicy to the stored network map of the epoch. Result
+// is the same as netmap.NetMap.PlaceObject returns. Returns ErrNotFound if
+// the network map is missing.
+func (x *Store) PlaceObject(epoch uint64, p netmap.PlacementPolicy, cnr cid.ID, obj oid.ID, opts ...netmap.PlacementOption) ([][]netmap.NodeInfo, error) {
+	nm, err := x.Get(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return nm.PlaceObject(p, cnr, obj, opts...)
+}
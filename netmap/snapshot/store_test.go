@@ -0,0 +1,108 @@
+package snapshot_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap/snapshot"
+	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "netmaps")
+
+	st, err := snapshot.NewStore(dir)
+	require.NoError(t, err)
+
+	epochs, err := st.Epochs()
+	require.NoError(t, err)
+	require.Empty(t, epochs)
+
+	_, err = st.Get(1)
+	require.ErrorIs(t, err, snapshot.ErrNotFound)
+
+	nms := make([]netmap.NetMap, 3)
+	for i := range nms {
+		nms[i] = netmaptest.NetMap()
+		nms[i].SetEpoch(uint64(10 - i))
+
+		require.NoError(t, st.Put(nms[i]))
+	}
+
+	// overwrite
+	nms[1] = netmaptest.NetMap()
+	nms[1].SetEpoch(9)
+	require.NoError(t, st.Put(nms[1]))
+
+	// foreign files are ignored
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), nil, 0600))
+
+	epochs, err = st.Epochs()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{8, 9, 10}, epochs)
+
+	for i := range nms {
+		nm, err := st.Get(nms[i].Epoch())
+		require.NoError(t, err)
+		require.Equal(t, nms[i], nm)
+	}
+
+	// reopen
+	st, err = snapshot.NewStore(dir)
+	require.NoError(t, err)
+
+	nm, err := st.Get(9)
+	require.NoError(t, err)
+	require.Equal(t, nms[1], nm)
+
+	require.NoError(t, st.Prune(10))
+
+	epochs, err = st.Epochs()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10}, epochs)
+
+	_, err = st.Get(9)
+	require.ErrorIs(t, err, snapshot.ErrNotFound)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "11.netmap"), []byte("corrupted"), 0600))
+
+	_, err = st.Get(11)
+	require.Error(t, err)
+
+	// epoch mismatch
+	require.NoError(t, os.Rename(filepath.Join(dir, "10.netmap"), filepath.Join(dir, "12.netmap")))
+
+	_, err = st.Get(12)
+	require.Error(t, err)
+}
+
+func TestStore_PlaceObject(t *testing.T) {
+	st, err := snapshot.NewStore(t.TempDir())
+	require.NoError(t, err)
+
+	var p netmap.PlacementPolicy
+	require.NoError(t, p.DecodeString(`REP 1
+CBF 1`))
+
+	cnr := cidtest.ID()
+	obj := oidtest.ID()
+
+	_, err = st.PlaceObject(1, p, cnr, obj)
+	require.ErrorIs(t, err, snapshot.ErrNotFound)
+
+	nm := netmaptest.NetMap()
+	nm.SetEpoch(1)
+	require.NoError(t, st.Put(nm))
+
+	exp, err := nm.PlaceObject(p, cnr, obj)
+	require.NoError(t, err)
+
+	res, err := st.PlaceObject(1, p, cnr, obj)
+	require.NoError(t, err)
+	require.Equal(t, exp, res)
+}
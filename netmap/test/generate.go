@@ -81,3 +81,13 @@ func NodeInfo() (x netmap.NodeInfo) {
 
 	return
 }
+
+// NetMap returns random netmap.NetMap.
+func NetMap() (x netmap.NetMap) {
+	nodes := []netmap.NodeInfo{NodeInfo(), NodeInfo()}
+
+	x.SetEpoch(rand.Uint64())
+	x.SetNodes(nodes)
+
+	return
+}
@@ -0,0 +1,164 @@
+package netmap
+
+import (
+	"github.com/nspcc-dev/neofs-sdk-go/reputation"
+)
+
+// Weighting builds WeightFunc for the nodes of the particular network map.
+// Weighting allows to change placement economics while keeping the same HRW
+// placement: see WithWeighting.
+type Weighting interface {
+	// WeightFunc returns WeightFunc for the given network map nodes.
+	// The argument MUST NOT be mutated.
+	WeightFunc(nodes []NodeInfo) WeightFunc
+}
+
+type (
+	defaultWeighting struct{}
+
+	uniformWeighting struct{}
+
+	capacityWeighting struct{}
+
+	reputationWeighting struct {
+		base Weighting
+
+		trusts map[string]float64
+
+		max float64
+	}
+)
+
+var (
+	_ Weighting = defaultWeighting{}
+	_ Weighting = uniformWeighting{}
+	_ Weighting = capacityWeighting{}
+	_ Weighting = (*reputationWeighting)(nil)
+)
+
+// DefaultWeighting returns Weighting used by default. It combines low price
+// and high capacity of the nodes: capacity is normalized by sigmoid scaled to
+// the mean capacity, price is normalized reversely to the minimum price.
+func DefaultWeighting() Weighting {
+	return defaultWeighting{}
+}
+
+// UniformWeighting returns Weighting which gives equal weights to all nodes,
+// so placement is determined by HRW hashing only.
+func UniformWeighting() Weighting {
+	return uniformWeighting{}
+}
+
+// CapacityWeighting returns Weighting which takes only capacity of the nodes
+// into account: it is normalized by sigmoid scaled to the mean capacity.
+func CapacityWeighting() Weighting {
+	return capacityWeighting{}
+}
+
+// ReputationWeighting returns Weighting which multiplies weights of the base
+// Weighting by global trust of the nodes normalized to the maximum trust.
+// Trust of the node is matched by public key, the last one is used if there
+// are several trusts of the same node. Nodes without trust get zero weight.
+// Use UniformWeighting as base to weight nodes by trust only.
+//
+// Base Weighting MUST NOT be nil.
+func ReputationWeighting(base Weighting, trusts []reputation.GlobalTrust) Weighting {
+	res := &reputationWeighting{
+		base:   base,
+		trusts: make(map[string]float64, len(trusts)),
+	}
+
+	for i := range trusts {
+		t := trusts[i].Trust()
+		res.trusts[string(t.Peer().PublicKey())] = t.Value()
+	}
+
+	for _, v := range res.trusts {
+		if v > res.max {
+			res.max = v
+		}
+	}
+
+	return res
+}
+
+func (defaultWeighting) WeightFunc(ns []NodeInfo) WeightFunc {
+	return defaultWeightFunc(ns)
+}
+
+func (uniformWeighting) WeightFunc([]NodeInfo) WeightFunc {
+	return func(NodeInfo) float64 {
+		return 1
+	}
+}
+
+func (capacityWeighting) WeightFunc(ns []NodeInfo) WeightFunc {
+	mean := NewMeanAggregator()
+
+	for i := range ns {
+		mean.Add(float64(ns[i].capacity()))
+	}
+
+	capNorm := NewSigmoidNormalizer(mean.Compute())
+
+	return func(n NodeInfo) float64 {
+		return capNorm.Normalize(float64(n.capacity()))
+	}
+}
+
+func (r *reputationWeighting) WeightFunc(ns []NodeInfo) WeightFunc {
+	wf := r.base.WeightFunc(ns)
+
+	return func(n NodeInfo) float64 {
+		if r.max == 0 {
+			return 0
+		}
+
+		return wf(n) * r.trusts[string(n.PublicKey())] / r.max
+	}
+}
+
+// PlacementOption configures placement of the nodes in ContainerNodes and
+// PlacementVectors.
+type PlacementOption func(*placementOptions)
+
+type placementOptions struct {
+	weighting Weighting
+
+	newBucketAgg func() Aggregator
+}
+
+// WithWeighting returns option to weight the nodes using the given Weighting.
+// DefaultWeighting is used by default.
+func WithWeighting(w Weighting) PlacementOption {
+	return func(o *placementOptions) {
+		o.weighting = w
+	}
+}
+
+// WithBucketAggregator returns option to calculate weights of the node buckets
+// (e.g. nodes of the same country) from the weights of their nodes by
+// the Aggregator returned from the given constructor. Constructor is called
+// for each bucket. Mean weight of the nodes from the interquartile range is
+// used by default (see NewMeanIQRAggregator).
+//
+// The option doesn't affect PlacementVectors.
+func WithBucketAggregator(newAgg func() Aggregator) PlacementOption {
+	return func(o *placementOptions) {
+		o.newBucketAgg = newAgg
+	}
+}
+
+// applyPlacementOptions returns options with defaults overridden by opts.
+func applyPlacementOptions(opts []PlacementOption) placementOptions {
+	res := placementOptions{
+		weighting:    DefaultWeighting(),
+		newBucketAgg: NewMeanIQRAggregator,
+	}
+
+	for i := range opts {
+		opts[i](&res)
+	}
+
+	return res
+}
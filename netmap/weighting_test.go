@@ -0,0 +1,114 @@
+package netmap
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/reputation"
+	"github.com/stretchr/testify/require"
+)
+
+func weightingNodeAttributes(i int) []string {
+	return []string{
+		"Country", strconv.Itoa(i % 2),
+		"Price", strconv.Itoa(1 + i%3),
+		"Capacity", strconv.Itoa(10 * (i + 1)),
+	}
+}
+
+func newGlobalTrust(pub []byte, val float64) reputation.GlobalTrust {
+	var peer reputation.PeerID
+	peer.SetPublicKey(pub)
+
+	var t reputation.Trust
+	t.SetPeer(peer)
+	t.SetValue(val)
+
+	var res reputation.GlobalTrust
+	res.Init()
+	res.SetTrust(t)
+
+	return res
+}
+
+func TestWeighting(t *testing.T) {
+	ns := nodeInfosFromAttributes(8, weightingNodeAttributes)
+
+	wf := DefaultWeighting().WeightFunc(ns)
+	require.Equal(t, defaultWeightFunc(ns)(ns[3]), wf(ns[3]))
+
+	wf = UniformWeighting().WeightFunc(ns)
+	for i := range ns {
+		require.EqualValues(t, 1, wf(ns[i]))
+	}
+
+	wf = CapacityWeighting().WeightFunc(ns)
+	for i := 1; i < len(ns); i++ {
+		// price is ignored
+		require.Greater(t, wf(ns[i]), wf(ns[i-1]))
+	}
+
+	wf = ReputationWeighting(UniformWeighting(), []reputation.GlobalTrust{
+		newGlobalTrust(ns[0].PublicKey(), 0.2),
+		newGlobalTrust(ns[1].PublicKey(), 0.5),
+		newGlobalTrust(ns[1].PublicKey(), 0.8),
+	}).WeightFunc(ns)
+
+	require.EqualValues(t, 0.25, wf(ns[0]))
+	require.EqualValues(t, 1, wf(ns[1]))
+	require.Zero(t, wf(ns[2]))
+
+	wf = ReputationWeighting(CapacityWeighting(), []reputation.GlobalTrust{
+		newGlobalTrust(ns[2].PublicKey(), 1),
+	}).WeightFunc(ns)
+
+	require.Equal(t, CapacityWeighting().WeightFunc(ns)(ns[2]), wf(ns[2]))
+
+	wf = ReputationWeighting(UniformWeighting(), nil).WeightFunc(ns)
+	require.Zero(t, wf(ns[0]))
+}
+
+func TestNetMap_ContainerNodesWithWeighting(t *testing.T) {
+	var nm NetMap
+	nm.SetNodes(nodeInfosFromAttributes(8, weightingNodeAttributes))
+
+	var p PlacementPolicy
+	require.NoError(t, p.DecodeString(`REP 2
+CBF 1`))
+
+	def, err := nm.ContainerNodes(p, []byte{1})
+	require.NoError(t, err)
+
+	res, err := nm.ContainerNodes(p, []byte{1}, WithWeighting(DefaultWeighting()))
+	require.NoError(t, err)
+	require.Equal(t, def, res)
+
+	// only trusted nodes are selected
+	w := WithWeighting(ReputationWeighting(UniformWeighting(), []reputation.GlobalTrust{
+		newGlobalTrust(nm.nodes[5].PublicKey(), 0.5),
+		newGlobalTrust(nm.nodes[6].PublicKey(), 1),
+	}))
+
+	res, err = nm.ContainerNodes(p, []byte{1}, w)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.ElementsMatch(t, []NodeInfo{nm.nodes[5], nm.nodes[6]}, res[0])
+
+	vectors, err := nm.PlacementVectors([][]NodeInfo{nm.nodes}, []byte{2}, w)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []NodeInfo{nm.nodes[5], nm.nodes[6]}, vectors[0][:2])
+
+	var aggs int
+
+	require.NoError(t, p.DecodeString(`REP 1 IN X
+CBF 1
+SELECT 1 IN DISTINCT Country FROM * AS X`))
+
+	_, err = nm.ContainerNodes(p, []byte{1}, WithBucketAggregator(func() Aggregator {
+		aggs++
+		return NewMinAggregator()
+	}))
+	require.NoError(t, err)
+	// one aggregator per Country
+	require.Equal(t, 2, aggs)
+}
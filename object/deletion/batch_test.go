@@ -0,0 +1,111 @@
+package deletion
+
+import (
+	"context"
+	"testing"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object/relations"
+	"github.com/stretchr/testify/require"
+)
+
+// tombstoneStorage stores tombstones only.
+type tombstoneStorage struct {
+	Storage
+
+	locked map[oid.ID]struct{}
+
+	ids     []oid.ID
+	members [][]oid.ID
+}
+
+func (x *tombstoneStorage) PutTombstone(_ context.Context, _ cid.ID, ts object.Tombstone, _ relations.Tokens) (oid.ID, error) {
+	for _, m := range ts.Members() {
+		if _, ok := x.locked[m]; ok {
+			return oid.ID{}, apistatus.ObjectLocked{}
+		}
+	}
+
+	id := oidtest.ID()
+
+	x.ids = append(x.ids, id)
+	x.members = append(x.members, ts.Members())
+
+	return id, nil
+}
+
+func TestPutBatch(t *testing.T) {
+	a := oidtest.ID()
+	b := []oid.ID{oidtest.ID(), oidtest.ID(), oidtest.ID()}
+	c := oidtest.ID()
+
+	newBatch := func() ([]Result, []batchItem) {
+		res := []Result{
+			{id: a, members: []oid.ID{a}},
+			{id: b[2], members: b},
+			{id: c, members: []oid.ID{c}},
+		}
+
+		batch := make([]batchItem, len(res))
+		for i := range res {
+			batch[i] = batchItem{i: i, members: res[i].members}
+		}
+
+		return res, batch
+	}
+
+	// members are split into tombstones: [a, b0], [b1, b2], [c]
+	t.Run("last tombstone rejected", func(t *testing.T) {
+		s := &tombstoneStorage{
+			locked: map[oid.ID]struct{}{c: {}},
+		}
+
+		res, batch := newBatch()
+		putBatch(context.Background(), s, cidtest.ID(), res, batch, 1, 2, relations.Tokens{})
+
+		// a and b are covered by the stored tombstones and are not retried
+		require.Equal(t, [][]oid.ID{{a, b[0]}, {b[1], b[2]}}, s.members)
+
+		require.Equal(t, StatusRemoved, res[0].Status())
+		ts, ok := res[0].Tombstone()
+		require.True(t, ok)
+		require.Equal(t, s.ids[0], ts)
+
+		require.Equal(t, StatusRemoved, res[1].Status())
+		ts, ok = res[1].Tombstone()
+		require.True(t, ok)
+		require.Equal(t, s.ids[1], ts)
+
+		require.Equal(t, StatusLocked, res[2].Status())
+		require.Error(t, res[2].Err())
+		_, ok = res[2].Tombstone()
+		require.False(t, ok)
+	})
+
+	t.Run("middle tombstone rejected", func(t *testing.T) {
+		s := &tombstoneStorage{
+			locked: map[oid.ID]struct{}{b[1]: {}},
+		}
+
+		res, batch := newBatch()
+		putBatch(context.Background(), s, cidtest.ID(), res, batch, 1, 2, relations.Tokens{})
+
+		// only uncovered members of b are retried
+		require.Equal(t, [][]oid.ID{{a, b[0]}, {c}}, s.members)
+
+		require.Equal(t, StatusRemoved, res[0].Status())
+		ts, _ := res[0].Tombstone()
+		require.Equal(t, s.ids[0], ts)
+
+		require.Equal(t, StatusLocked, res[1].Status())
+
+		require.Equal(t, StatusRemoved, res[2].Status())
+		ts, _ = res[2].Tombstone()
+		require.Equal(t, s.ids[1], ts)
+	})
+}
@@ -0,0 +1,329 @@
+package deletion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/relations"
+)
+
+// Storage is a NeoFS storage of objects which can resolve object relations
+// and store tombstones.
+type Storage interface {
+	relations.Relations
+
+	// CurrentEpoch returns number of the current NeoFS epoch.
+	CurrentEpoch(ctx context.Context) (uint64, error)
+
+	// PutTombstone stores tombstone object with the given tombstone in the
+	// container. Expiration epoch of the tombstone must also be set in
+	// the object attribute (see object.AttributeExpirationEpoch).
+	// Returns identifier of the stored object.
+	PutTombstone(ctx context.Context, cnrID cid.ID, ts object.Tombstone, tokens relations.Tokens) (oid.ID, error)
+}
+
+// DefaultTombstoneLifetime is a default number of epochs during which
+// tombstones are stored in NeoFS.
+const DefaultTombstoneLifetime = 5
+
+// DefaultMaxMembers is a default limit of the tombstone members.
+const DefaultMaxMembers = 1000
+
+// Prm groups parameters of Delete operation.
+type Prm struct {
+	lifetime uint64
+
+	expSet bool
+	exp    uint64
+
+	maxMembers int
+
+	tokens relations.Tokens
+}
+
+// SetTombstoneLifetime sets number of epochs after the current one during
+// which tombstones are stored. Defaults to DefaultTombstoneLifetime.
+//
+// Overridden by SetExpirationEpoch.
+func (x *Prm) SetTombstoneLifetime(epochs uint64) {
+	x.lifetime = epochs
+}
+
+// SetExpirationEpoch sets the last epoch of the tombstones lifetime.
+func (x *Prm) SetExpirationEpoch(epoch uint64) {
+	x.exp = epoch
+	x.expSet = true
+}
+
+// SetMaxMembers limits number of objects per tombstone. Non-positive value
+// means DefaultMaxMembers.
+//
+// Note that objects of the same split hierarchy are placed into the same
+// tombstone as long as the limit allows it.
+func (x *Prm) SetMaxMembers(n int) {
+	x.maxMembers = n
+}
+
+// SetTokens specifies tokens to be attached to all requests.
+func (x *Prm) SetTokens(tokens relations.Tokens) {
+	x.tokens = tokens
+}
+
+// Status describes outcome of the object deletion.
+type Status uint8
+
+const (
+	// StatusFailed is an outcome of the failed deletion. The cause is
+	// returned by Result.Err.
+	StatusFailed Status = iota
+
+	// StatusRemoved is an outcome of the successful deletion.
+	StatusRemoved
+
+	// StatusAlreadyRemoved is an outcome of the deletion of the object which
+	// had been already removed.
+	StatusAlreadyRemoved
+
+	// StatusNotFound is an outcome of the deletion of the missing object.
+	StatusNotFound
+
+	// StatusLocked is an outcome of the deletion of the locked object.
+	StatusLocked
+)
+
+// String implements fmt.Stringer.
+func (x Status) String() string {
+	switch x {
+	default:
+		return fmt.Sprintf("UNKNOWN#%d", x)
+	case StatusFailed:
+		return "FAILED"
+	case StatusRemoved:
+		return "REMOVED"
+	case StatusAlreadyRemoved:
+		return "ALREADY_REMOVED"
+	case StatusNotFound:
+		return "NOT_FOUND"
+	case StatusLocked:
+		return "LOCKED"
+	}
+}
+
+// Result describes outcome of the particular object deletion.
+type Result struct {
+	id oid.ID
+
+	st Status
+
+	tsSet bool
+	ts    oid.ID
+
+	members []oid.ID
+
+	err error
+}
+
+// Object returns identifier of the requested object.
+func (x Result) Object() oid.ID {
+	return x.id
+}
+
+// Status returns deletion outcome.
+func (x Result) Status() Status {
+	return x.st
+}
+
+// Tombstone returns identifier of the tombstone which covers the object.
+// Returns false if object has not been removed.
+//
+// For split objects, returns the tombstone which includes the last member
+// of the split hierarchy.
+func (x Result) Tombstone() (oid.ID, bool) {
+	return x.ts, x.tsSet
+}
+
+// Members returns identifiers of all physical objects which have been
+// resolved for the requested one (including the object itself).
+//
+// Result must not be mutated.
+func (x Result) Members() []oid.ID {
+	return x.members
+}
+
+// Err returns the cause of the unsuccessful deletion. Returns nil for
+// StatusRemoved.
+func (x Result) Err() error {
+	return x.err
+}
+
+// Delete removes the objects from the container. All physical objects of
+// the split hierarchy are collected for each object using
+// relations.ListAllRelations, and then all collected objects are grouped into
+// tombstones with the configured expiration.
+//
+// Results are returned for each requested object in the order of ids.
+// Error is returned only if the operation could not be started.
+func Delete(ctx context.Context, s Storage, cnrID cid.ID, ids []oid.ID, prm Prm) ([]Result, error) {
+	exp := prm.exp
+
+	if !prm.expSet {
+		epoch, err := s.CurrentEpoch(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("read current epoch: %w", err)
+		}
+
+		lifetime := prm.lifetime
+		if lifetime == 0 {
+			lifetime = DefaultTombstoneLifetime
+		}
+
+		exp = epoch + lifetime
+	}
+
+	maxMembers := prm.maxMembers
+	if maxMembers <= 0 {
+		maxMembers = DefaultMaxMembers
+	}
+
+	res := make([]Result, len(ids))
+
+	var (
+		batch   []batchItem
+		members int
+	)
+
+	flush := func() {
+		putBatch(ctx, s, cnrID, res, batch, exp, maxMembers, prm.tokens)
+		batch = batch[:0]
+		members = 0
+	}
+
+	for i := range ids {
+		res[i].id = ids[i]
+
+		children, err := relations.ListAllRelations(ctx, s, cnrID, ids[i], prm.tokens)
+		if err != nil {
+			res[i].st, res[i].err = statusFromError(err), err
+			continue
+		}
+
+		res[i].members = append(children, ids[i])
+
+		if members > 0 && members+len(res[i].members) > maxMembers {
+			flush()
+		}
+
+		batch = append(batch, batchItem{
+			i:       i,
+			members: res[i].members,
+		})
+		members += len(res[i].members)
+	}
+
+	if len(batch) > 0 {
+		flush()
+	}
+
+	return res, nil
+}
+
+// batchItem refers to the requested object and its members which are not
+// covered by tombstones yet.
+type batchItem struct {
+	i int
+
+	members []oid.ID
+}
+
+// putBatch stores tombstones for the members of the given items and writes
+// the outcomes into res. Members are split into tombstones of at most
+// maxMembers objects which are stored sequentially until the first failure.
+// Objects all members of which have been covered are reported as removed.
+// If tombstone is rejected because of the locked object, each remaining object
+// is retried separately with its uncovered members in order to find out
+// locked ones.
+func putBatch(ctx context.Context, s Storage, cnrID cid.ID, res []Result, batch []batchItem, exp uint64, maxMembers int, tokens relations.Tokens) {
+	var members []oid.ID
+
+	for _, it := range batch {
+		members = append(members, it.members...)
+	}
+
+	var (
+		// tombstones of the stored chunks in order
+		tss []oid.ID
+		err error
+	)
+
+	for off := 0; off < len(members); off += maxMembers {
+		end := off + maxMembers
+		if end > len(members) {
+			end = len(members)
+		}
+
+		var ts object.Tombstone
+		ts.SetExpirationEpoch(exp)
+		ts.SetMembers(members[off:end])
+
+		var tsID oid.ID
+
+		tsID, err = s.PutTombstone(ctx, cnrID, ts, tokens)
+		if err != nil {
+			break
+		}
+
+		tss = append(tss, tsID)
+	}
+
+	covered := len(tss) * maxMembers
+	retry := err != nil && len(batch) > 1 && statusFromError(err) == StatusLocked
+
+	var (
+		off     int
+		retries []batchItem
+	)
+
+	for _, it := range batch {
+		end := off + len(it.members)
+
+		switch {
+		case end <= covered:
+			res[it.i].st = StatusRemoved
+			res[it.i].ts = tss[(end-1)/maxMembers]
+			res[it.i].tsSet = true
+		case retry:
+			if off < covered {
+				it.members = it.members[covered-off:]
+			}
+
+			retries = append(retries, it)
+		default:
+			res[it.i].st, res[it.i].err = statusFromError(err), err
+		}
+
+		off = end
+	}
+
+	for _, it := range retries {
+		putBatch(ctx, s, cnrID, res, []batchItem{it}, exp, maxMembers, tokens)
+	}
+}
+
+// statusFromError classifies the error of the deletion stage.
+func statusFromError(err error) Status {
+	switch {
+	default:
+		return StatusFailed
+	case errors.Is(err, apistatus.ErrObjectAlreadyRemoved):
+		return StatusAlreadyRemoved
+	case errors.Is(err, apistatus.ErrObjectNotFound):
+		return StatusNotFound
+	case errors.Is(err, apistatus.ErrObjectLocked):
+		return StatusLocked
+	}
+}
@@ -0,0 +1,180 @@
+package deletion_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/deletion"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object/relations"
+	"github.com/stretchr/testify/require"
+)
+
+type testStorage struct {
+	epoch uint64
+
+	// root -> linking object and children
+	linked map[oid.ID][2][]oid.ID
+	// objects returning particular error on header request
+	headErr map[oid.ID]error
+	locked  map[oid.ID]struct{}
+
+	tombstones []object.Tombstone
+}
+
+func (x *testStorage) GetSplitInfo(_ context.Context, _ cid.ID, id oid.ID, _ relations.Tokens) (*object.SplitInfo, error) {
+	if err, ok := x.headErr[id]; ok {
+		return nil, err
+	}
+
+	l, ok := x.linked[id]
+	if !ok {
+		return nil, relations.ErrNoSplitInfo
+	}
+
+	si := object.NewSplitInfo()
+	si.SetLink(l[0][0])
+
+	return si, nil
+}
+
+func (x *testStorage) ListChildrenByLinker(_ context.Context, _ cid.ID, link oid.ID, _ relations.Tokens) ([]oid.ID, error) {
+	for _, l := range x.linked {
+		if l[0][0].Equals(link) {
+			return append([]oid.ID(nil), l[1]...), nil
+		}
+	}
+
+	return nil, errors.New("unknown linking object")
+}
+
+func (x *testStorage) GetLeftSibling(context.Context, cid.ID, oid.ID, relations.Tokens) (oid.ID, error) {
+	panic("unexpected call")
+}
+
+func (x *testStorage) FindSiblingBySplitID(context.Context, cid.ID, *object.SplitID, relations.Tokens) ([]oid.ID, error) {
+	panic("unexpected call")
+}
+
+func (x *testStorage) FindSiblingByParentID(context.Context, cid.ID, oid.ID, relations.Tokens) ([]oid.ID, error) {
+	panic("unexpected call")
+}
+
+func (x *testStorage) CurrentEpoch(context.Context) (uint64, error) {
+	return x.epoch, nil
+}
+
+func (x *testStorage) PutTombstone(_ context.Context, _ cid.ID, ts object.Tombstone, _ relations.Tokens) (oid.ID, error) {
+	for _, m := range ts.Members() {
+		if _, ok := x.locked[m]; ok {
+			return oid.ID{}, apistatus.ObjectLocked{}
+		}
+	}
+
+	x.tombstones = append(x.tombstones, ts)
+
+	return oidtest.ID(), nil
+}
+
+func TestDelete(t *testing.T) {
+	var (
+		regular        = oidtest.ID()
+		root           = oidtest.ID()
+		link           = oidtest.ID()
+		children       = []oid.ID{oidtest.ID(), oidtest.ID()}
+		locked         = oidtest.ID()
+		alreadyRemoved = oidtest.ID()
+		missing        = oidtest.ID()
+		failed         = oidtest.ID()
+	)
+
+	s := &testStorage{
+		epoch: 10,
+		linked: map[oid.ID][2][]oid.ID{
+			root: {{link}, children},
+		},
+		headErr: map[oid.ID]error{
+			alreadyRemoved: new(apistatus.ObjectAlreadyRemoved),
+			missing:        apistatus.ObjectNotFound{},
+			failed:         errors.New("any error"),
+		},
+		locked: map[oid.ID]struct{}{
+			locked: {},
+		},
+	}
+
+	ids := []oid.ID{regular, root, locked, alreadyRemoved, missing, failed}
+
+	res, err := deletion.Delete(context.Background(), s, cidtest.ID(), ids, deletion.Prm{})
+	require.NoError(t, err)
+	require.Len(t, res, len(ids))
+
+	for i := range res {
+		require.Equal(t, ids[i], res[i].Object())
+	}
+
+	for i, st := range []deletion.Status{
+		deletion.StatusRemoved,
+		deletion.StatusRemoved,
+		deletion.StatusLocked,
+		deletion.StatusAlreadyRemoved,
+		deletion.StatusNotFound,
+		deletion.StatusFailed,
+	} {
+		require.Equal(t, st, res[i].Status(), i)
+
+		_, ok := res[i].Tombstone()
+		require.Equal(t, st == deletion.StatusRemoved, ok, i)
+		require.Equal(t, st == deletion.StatusRemoved, res[i].Err() == nil, i)
+	}
+
+	require.ElementsMatch(t, append(children, link, root), res[1].Members())
+
+	// first attempt is rejected because of the locked object, then each
+	// object is processed separately
+	require.Len(t, s.tombstones, 2)
+	require.Equal(t, []oid.ID{regular}, s.tombstones[0].Members())
+	require.ElementsMatch(t, append(children, link, root), s.tombstones[1].Members())
+
+	for i := range s.tombstones {
+		require.EqualValues(t, 10+deletion.DefaultTombstoneLifetime, s.tombstones[i].ExpirationEpoch())
+	}
+}
+
+func TestDelete_Batching(t *testing.T) {
+	s := new(testStorage)
+
+	ids := make([]oid.ID, 5)
+	for i := range ids {
+		ids[i] = oidtest.ID()
+	}
+
+	var prm deletion.Prm
+	prm.SetMaxMembers(2)
+	prm.SetExpirationEpoch(42)
+
+	res, err := deletion.Delete(context.Background(), s, cidtest.ID(), ids, prm)
+	require.NoError(t, err)
+
+	for i := range res {
+		require.Equal(t, deletion.StatusRemoved, res[i].Status())
+	}
+
+	require.Len(t, s.tombstones, 3)
+
+	var members []oid.ID
+
+	for i := range s.tombstones {
+		require.LessOrEqual(t, len(s.tombstones[i].Members()), 2)
+		require.EqualValues(t, 42, s.tombstones[i].ExpirationEpoch())
+		members = append(members, s.tombstones[i].Members()...)
+	}
+
+	require.Equal(t, ids, members)
+}
@@ -0,0 +1,23 @@
+/*
+Package deletion provides feature to remove objects in bulk.
+
+Delete resolves all physical objects of the split hierarchies and groups them
+into tombstones. Storage is an interface of entity that can resolve object
+relations and store tombstones. Both client.Client and pool.Pool provide
+bulk deletion on top of this package.
+
+Remove objects and check the outcomes:
+
+	var prm deletion.Prm
+	prm.SetTombstoneLifetime(10)
+
+	res, err := deletion.Delete(ctx, storage, cnrID, ids, prm)
+	// ...
+
+	for i := range res {
+		if res[i].Status() != deletion.StatusRemoved {
+			// ...
+		}
+	}
+*/
+package deletion
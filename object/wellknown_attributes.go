@@ -1,5 +1,7 @@
 package object
 
+import v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+
 const (
 	// AttributeName is an attribute key that is commonly used to denote
 	// human-friendly name.
@@ -25,4 +27,9 @@ const (
 	// AttributeContentType is an attribute key that is commonly used to denote
 	// MIME Content Type of object's payload.
 	AttributeContentType = "Content-Type"
+
+	// AttributeExpirationEpoch is a system attribute key that is used to denote
+	// the last NeoFS epoch of the object lifetime in decimal format. Expired
+	// objects are removed by the storage nodes.
+	AttributeExpirationEpoch = v2object.SysAttributeExpEpoch
 )
@@ -0,0 +1,332 @@
+package pool
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	defaultCircuitWindow      = 10 * time.Second
+	defaultCircuitMinRequests = 10
+	defaultCircuitCooldown    = 5 * time.Second
+)
+
+// CircuitState is a state of the circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed is a state of the circuit breaker in which the requests are
+	// passed to the node while the failures are counted.
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen is a state of the circuit breaker in which the node is
+	// excluded from the request routing until the cooldown expires.
+	CircuitOpen
+
+	// CircuitHalfOpen is a state of the circuit breaker in which a single probe
+	// request is passed to the node. Successful probe closes the circuit, failed
+	// one opens it again.
+	CircuitHalfOpen
+)
+
+// String implements fmt.Stringer.
+func (x CircuitState) String() string {
+	switch x {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitStateChange groups info about the state change of the circuit breaker.
+type CircuitStateChange struct {
+	// Address of the node.
+	Address string
+	// Method of the circuit breaker. Meaningful only if circuit breakers are
+	// maintained per method (see CircuitBreakerParams.EnablePerMethod).
+	Method MethodIndex
+	From   CircuitState
+	To     CircuitState
+}
+
+// CircuitBreakerParams groups parameters of the node circuit breakers. Circuit
+// breaker counts requests to the node and the failures among them (transport
+// errors and server failure statuses) within the window. Once the failure ratio
+// is reached, the circuit opens and the node is excluded from the request
+// routing for the cooldown. After that a single probe request is passed to the
+// node (half-open circuit): the circuit is closed on success and opened again
+// on failure.
+//
+// Circuit breakers are enabled if the failure ratio is set.
+type CircuitBreakerParams struct {
+	failureRatio float64
+	window       time.Duration
+	minRequests  uint32
+	cooldown     time.Duration
+	perMethod    bool
+	callback     func(CircuitStateChange)
+}
+
+// SetFailureRatio sets ratio of the failed requests within the window after
+// which the circuit opens. Must be in (0; 1] range, non-positive value
+// (default) disables circuit breakers.
+func (x *CircuitBreakerParams) SetFailureRatio(ratio float64) {
+	x.failureRatio = ratio
+}
+
+// SetWindow sets duration of the window the requests are counted within.
+// If not set, 10s is used.
+func (x *CircuitBreakerParams) SetWindow(window time.Duration) {
+	x.window = window
+}
+
+// SetMinRequests sets minimum number of the requests within the window
+// required to open the circuit. If not set, 10 is used.
+func (x *CircuitBreakerParams) SetMinRequests(n uint32) {
+	x.minRequests = n
+}
+
+// SetCooldown sets duration of the open circuit after which the probe request
+// is passed to the node. If not set, 5s is used.
+func (x *CircuitBreakerParams) SetCooldown(cooldown time.Duration) {
+	x.cooldown = cooldown
+}
+
+// EnablePerMethod makes the circuit breakers to be maintained for each
+// method of the node (see MethodIndex) instead of the whole node. With this
+// option node failing some requests still serves the other ones.
+func (x *CircuitBreakerParams) EnablePerMethod() {
+	x.perMethod = true
+}
+
+// SetStateChangeCallback sets callback that will be invoked on each state
+// change of the circuit breakers. Callback must not block.
+func (x *CircuitBreakerParams) SetStateChangeCallback(f func(CircuitStateChange)) {
+	x.callback = f
+}
+
+// enabled checks if circuit breakers are configured.
+func (x CircuitBreakerParams) enabled() bool {
+	return x.failureRatio > 0
+}
+
+// circuitBreakers is a set of circuit breakers of the single node.
+type circuitBreakers struct {
+	node    *circuitBreaker
+	methods []*circuitBreaker
+}
+
+// newCircuitBreakers constructs circuit breakers of the node with the given
+// address. Returns nil if circuit breakers are disabled.
+func newCircuitBreakers(addr string, prm CircuitBreakerParams) *circuitBreakers {
+	if !prm.enabled() {
+		return nil
+	}
+
+	if prm.window <= 0 {
+		prm.window = defaultCircuitWindow
+	}
+
+	if prm.minRequests == 0 {
+		prm.minRequests = defaultCircuitMinRequests
+	}
+
+	if prm.cooldown <= 0 {
+		prm.cooldown = defaultCircuitCooldown
+	}
+
+	var res circuitBreakers
+
+	if !prm.perMethod {
+		res.node = newCircuitBreaker(addr, methodLast, prm)
+		return &res
+	}
+
+	res.methods = make([]*circuitBreaker, methodLast)
+	for i := range res.methods {
+		res.methods[i] = newCircuitBreaker(addr, MethodIndex(i), prm)
+	}
+
+	return &res
+}
+
+// get returns circuit breaker responsible for the given method. Returns nil
+// if there is no such circuit breaker. Note that methodLast is used for the
+// requests of unknown method.
+func (x *circuitBreakers) get(method MethodIndex) *circuitBreaker {
+	if x == nil {
+		return nil
+	}
+
+	if x.node != nil {
+		return x.node
+	}
+
+	if method >= 0 && int(method) < len(x.methods) {
+		return x.methods[method]
+	}
+
+	return nil
+}
+
+// state returns state of the node circuit breaker or the most restrictive state
+// of the method circuit breakers.
+func (x *circuitBreakers) state() CircuitState {
+	if x == nil {
+		return CircuitClosed
+	}
+
+	if x.node != nil {
+		return x.node.currentState()
+	}
+
+	res := CircuitClosed
+
+	for i := range x.methods {
+		switch st := x.methods[i].currentState(); st {
+		case CircuitOpen:
+			return st
+		case CircuitHalfOpen:
+			res = st
+		}
+	}
+
+	return res
+}
+
+// circuitBreaker is a state machine of the single circuit.
+type circuitBreaker struct {
+	prm    CircuitBreakerParams
+	addr   string
+	method MethodIndex
+	now    func() time.Time
+
+	mu          sync.Mutex
+	state       CircuitState
+	windowStart time.Time
+	requests    uint32
+	failures    uint32
+	// changedAt is a time of the circuit opening in open state and a time of the
+	// probe request in half-open state
+	changedAt time.Time
+	probing   bool
+}
+
+func newCircuitBreaker(addr string, method MethodIndex, prm CircuitBreakerParams) *circuitBreaker {
+	return &circuitBreaker{
+		prm:    prm,
+		addr:   addr,
+		method: method,
+		now:    time.Now,
+	}
+}
+
+// currentState returns state of the circuit.
+func (x *circuitBreaker) currentState() CircuitState {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.state
+}
+
+// allow checks if the request can be passed to the node. In half-open state
+// the only probe request is allowed. The probe is repeated if its result was
+// not recorded within the cooldown (e.g. node was selected but not requested).
+func (x *circuitBreaker) allow() bool {
+	x.mu.Lock()
+
+	now := x.now()
+	from := x.state
+
+	switch x.state {
+	case CircuitClosed:
+		x.mu.Unlock()
+		return true
+	case CircuitOpen:
+		if now.Sub(x.changedAt) < x.prm.cooldown {
+			x.mu.Unlock()
+			return false
+		}
+
+		x.state = CircuitHalfOpen
+	case CircuitHalfOpen:
+		if x.probing && now.Sub(x.changedAt) < x.prm.cooldown {
+			x.mu.Unlock()
+			return false
+		}
+	}
+
+	x.probing = true
+	x.changedAt = now
+	to := x.state
+
+	x.mu.Unlock()
+
+	x.notify(from, to)
+
+	return true
+}
+
+// record counts result of the request to the node.
+func (x *circuitBreaker) record(failed bool) {
+	x.mu.Lock()
+
+	now := x.now()
+	from := x.state
+
+	switch x.state {
+	case CircuitClosed:
+		if now.Sub(x.windowStart) >= x.prm.window {
+			x.windowStart = now
+			x.requests = 0
+			x.failures = 0
+		}
+
+		x.requests++
+		if failed {
+			x.failures++
+		}
+
+		if x.requests >= x.prm.minRequests && float64(x.failures) >= x.prm.failureRatio*float64(x.requests) {
+			x.state = CircuitOpen
+			x.changedAt = now
+		}
+	case CircuitOpen:
+		// result of the request passed before the circuit opening
+	case CircuitHalfOpen:
+		x.probing = false
+
+		if failed {
+			x.state = CircuitOpen
+			x.changedAt = now
+		} else {
+			x.state = CircuitClosed
+			x.windowStart = now
+			x.requests = 0
+			x.failures = 0
+		}
+	}
+
+	to := x.state
+
+	x.mu.Unlock()
+
+	x.notify(from, to)
+}
+
+func (x *circuitBreaker) notify(from, to CircuitState) {
+	if from == to || x.prm.callback == nil {
+		return
+	}
+
+	x.prm.callback(CircuitStateChange{
+		Address: x.addr,
+		Method:  x.method,
+		From:    from,
+		To:      to,
+	})
+}
@@ -0,0 +1,213 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var (
+		now     = time.Now()
+		changes []CircuitStateChange
+		prm     CircuitBreakerParams
+	)
+
+	prm.SetFailureRatio(0.5)
+	prm.SetMinRequests(4)
+	prm.SetWindow(time.Minute)
+	prm.SetCooldown(time.Second)
+	prm.SetStateChangeCallback(func(change CircuitStateChange) {
+		changes = append(changes, change)
+	})
+
+	cb := newCircuitBreakers("peer0", prm).get(methodObjectGet)
+	require.NotNil(t, cb)
+	cb.now = func() time.Time { return now }
+
+	// failures of the previous window are not counted
+	cb.record(true)
+	cb.record(true)
+	now = now.Add(time.Minute)
+
+	cb.record(true)
+	cb.record(false)
+	cb.record(false)
+	require.Equal(t, CircuitClosed, cb.currentState())
+	require.True(t, cb.allow())
+
+	cb.record(true)
+	require.Equal(t, CircuitOpen, cb.currentState())
+	require.False(t, cb.allow())
+
+	// single probe after the cooldown
+	now = now.Add(time.Second)
+	require.True(t, cb.allow())
+	require.Equal(t, CircuitHalfOpen, cb.currentState())
+	require.False(t, cb.allow())
+
+	cb.record(true)
+	require.Equal(t, CircuitOpen, cb.currentState())
+	require.False(t, cb.allow())
+
+	now = now.Add(time.Second)
+	require.True(t, cb.allow())
+
+	// probe is repeated if its result is lost
+	now = now.Add(time.Second)
+	require.True(t, cb.allow())
+
+	cb.record(false)
+	require.Equal(t, CircuitClosed, cb.currentState())
+
+	// counters are reset on close
+	cb.record(true)
+	cb.record(true)
+	cb.record(true)
+	require.Equal(t, CircuitClosed, cb.currentState())
+
+	require.Equal(t, []CircuitStateChange{
+		{Address: "peer0", Method: methodLast, From: CircuitClosed, To: CircuitOpen},
+		{Address: "peer0", Method: methodLast, From: CircuitOpen, To: CircuitHalfOpen},
+		{Address: "peer0", Method: methodLast, From: CircuitHalfOpen, To: CircuitOpen},
+		{Address: "peer0", Method: methodLast, From: CircuitOpen, To: CircuitHalfOpen},
+		{Address: "peer0", Method: methodLast, From: CircuitHalfOpen, To: CircuitClosed},
+	}, changes)
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	require.Nil(t, newCircuitBreakers("peer0", CircuitBreakerParams{}))
+
+	monitor := newClientStatusMonitor("peer0", 100)
+	for i := 0; i < 10; i++ {
+		_ = monitor.handleError(nil, errors.New("error"))
+	}
+
+	require.True(t, monitor.allowRequest(methodLast))
+	require.Equal(t, CircuitClosed, monitor.circuitState())
+}
+
+func TestCircuitBreakerPerMethod(t *testing.T) {
+	var prm CircuitBreakerParams
+	prm.SetFailureRatio(1)
+	prm.SetMinRequests(2)
+	prm.EnablePerMethod()
+
+	monitor := newClientStatusMonitor("peer0", 100)
+	monitor.breakers = newCircuitBreakers("peer0", prm)
+
+	// client faults don't open the circuit
+	for i := 0; i < 2; i++ {
+		_ = monitor.handleMethodError(methodObjectHead, apistatus.ObjectNotFound{}, nil)
+	}
+	require.Equal(t, CircuitClosed, monitor.circuitState())
+
+	for i := 0; i < 2; i++ {
+		_ = monitor.handleMethodError(methodObjectGet, apistatus.ServerInternal{}, nil)
+	}
+
+	require.Equal(t, CircuitOpen, monitor.circuitState())
+	require.False(t, monitor.allowRequest(methodObjectGet))
+	require.True(t, monitor.allowRequest(methodObjectHead))
+	require.True(t, monitor.allowRequest(methodLast))
+	require.True(t, monitor.isHealthy())
+}
+
+func TestCircuitBreakerConnection(t *testing.T) {
+	var prm CircuitBreakerParams
+	prm.SetFailureRatio(1)
+	prm.SetMinRequests(1)
+	prm.SetCooldown(time.Hour)
+
+	clients := make([]client, 2)
+	for i, addr := range []string{"peer0", "peer1"} {
+		mock := newMockClient(addr, *newPrivateKey(t))
+		mock.breakers = newCircuitBreakers(addr, prm)
+		clients[i] = mock
+	}
+
+	inner := &innerPool{
+		sampler: newSampler([]float64{0.5, 0.5}, rand.NewSource(0)),
+		clients: clients,
+	}
+
+	_ = clients[0].(*mockClient).handleError(nil, errors.New("error"))
+
+	for i := 0; i < 100; i++ {
+		cp, err := inner.connection()
+		require.NoError(t, err)
+		require.Equal(t, "peer1", cp.address())
+	}
+
+	_ = clients[1].(*mockClient).handleError(nil, errors.New("error"))
+
+	_, err := inner.connection()
+	require.Error(t, err)
+}
+
+func TestPool_CircuitBreakerPerMethod(t *testing.T) {
+	var prmBreaker CircuitBreakerParams
+	prmBreaker.SetFailureRatio(1)
+	prmBreaker.SetMinRequests(1)
+	prmBreaker.SetCooldown(time.Hour)
+	prmBreaker.EnablePerMethod()
+
+	failing := newMockClient("peer0", *newPrivateKey(t))
+	failing.errOnListContainer()
+	failing.errOnObjectSearch()
+
+	opts := InitParameters{
+		key: newPrivateKey(t),
+		nodeParams: []NodeParam{
+			{1, "peer0", 1},
+			{2, "peer1", 1},
+		},
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.SetCircuitBreaker(prmBreaker)
+	opts.setClientBuilder(func(addr string) client {
+		mock := failing
+		if addr != "peer0" {
+			mock = newMockClient(addr, *newPrivateKey(t))
+		}
+
+		mock.breakers = newCircuitBreakers(addr, prmBreaker)
+
+		return mock
+	})
+
+	ctx := context.Background()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, pool.Dial(ctx))
+	t.Cleanup(pool.Close)
+
+	// the first requests open circuits of peer0 methods, the next ones are
+	// served by peer1
+	_, err = pool.ListContainers(ctx, PrmContainerList{})
+	require.Error(t, err)
+	require.False(t, failing.allowRequest(methodContainerList))
+
+	_, err = pool.ListContainers(ctx, PrmContainerList{})
+	require.NoError(t, err)
+
+	_, err = pool.SearchObjects(ctx, PrmObjectSearch{})
+	require.Error(t, err)
+	require.False(t, failing.allowRequest(methodObjectSearch))
+
+	_, err = pool.SearchObjects(ctx, PrmObjectSearch{})
+	require.NoError(t, err)
+
+	// other methods are still served by peer0
+	require.True(t, failing.allowRequest(methodContainerGet))
+
+	cp, err := pool.connectionFor(methodContainerGet)
+	require.NoError(t, err)
+	require.Equal(t, "peer0", cp.address())
+}
@@ -0,0 +1,24 @@
+/*
+Package containerfs provides read-only file system view over NeoFS container.
+
+FS implements io/fs.FS, fs.ReadDirFS and fs.StatFS interfaces. Objects are
+mapped to files according to object.AttributeFilePath (or
+object.AttributeFileName for objects without path) attribute, and directories
+are formed from the path prefixes. Paths are relative to the container root
+with optional leading slash, so both "/dir/file.txt" and "dir/file.txt" values
+refer to the same file (the newest object wins). Modification time of the files is taken
+from object.AttributeTimestamp.
+
+Serve container over HTTP:
+
+	fsys := containerfs.NewFromPool(ctx, p, cnrID)
+
+	http.Handle("/", http.FileServer(http.FS(fsys)))
+
+Walk the container tree:
+
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		// ...
+	})
+*/
+package containerfs
@@ -0,0 +1,548 @@
+package containerfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/pool/internal/objects"
+)
+
+// FS is a read-only file system view over NeoFS container.
+//
+// FS should be created using New or NewFromPool.
+type FS struct {
+	ctx context.Context
+
+	s Storage
+
+	cnr cid.ID
+}
+
+// New returns FS of the referenced container working through the given
+// Storage. Context is used for all network operations.
+func New(ctx context.Context, s Storage, cnrID cid.ID) *FS {
+	return &FS{
+		ctx: ctx,
+		s:   s,
+		cnr: cnrID,
+	}
+}
+
+// FileInfo describes file or directory of the FS. Implements fs.FileInfo.
+type FileInfo struct {
+	name string
+
+	dir bool
+
+	addr oid.Address
+	hdr  object.Object
+
+	modTime time.Time
+}
+
+// Name implements fs.FileInfo.
+func (x FileInfo) Name() string {
+	return x.name
+}
+
+// Size implements fs.FileInfo. Returns payload size of the object for files.
+func (x FileInfo) Size() int64 {
+	if x.dir {
+		return 0
+	}
+
+	return int64(x.hdr.PayloadSize())
+}
+
+// Mode implements fs.FileInfo. Files and directories are read-only.
+func (x FileInfo) Mode() fs.FileMode {
+	if x.dir {
+		return fs.ModeDir | 0555
+	}
+
+	return 0444
+}
+
+// ModTime implements fs.FileInfo. Returns time from the Timestamp attribute
+// of the object for files, and zero time for directories.
+func (x FileInfo) ModTime() time.Time {
+	return x.modTime
+}
+
+// IsDir implements fs.FileInfo.
+func (x FileInfo) IsDir() bool {
+	return x.dir
+}
+
+// Sys implements fs.FileInfo. Returns object.Object header for files, and
+// nil for directories.
+func (x FileInfo) Sys() interface{} {
+	if x.dir {
+		return nil
+	}
+
+	return x.hdr
+}
+
+// ContentType returns MIME type of the file from the Content-Type attribute
+// of the object. Returns empty string if attribute is missing or for
+// directories.
+func (x FileInfo) ContentType() string {
+	return objects.Attribute(x.hdr, object.AttributeContentType)
+}
+
+// Address returns address of the object representing the file. Returns false
+// for directories.
+func (x FileInfo) Address() (oid.Address, bool) {
+	return x.addr, !x.dir
+}
+
+// Open implements fs.FS. Files are opened lazily: payload is requested
+// on the first read.
+func (x *FS) Open(name string) (fs.File, error) {
+	info, err := x.stat("open", name)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.dir {
+		return &dir{
+			fsys: x,
+			name: name,
+			info: info,
+		}, nil
+	}
+
+	return &file{
+		fsys: x,
+		name: name,
+		info: info,
+	}, nil
+}
+
+// Stat implements fs.StatFS.
+func (x *FS) Stat(name string) (fs.FileInfo, error) {
+	info, err := x.stat("stat", name)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// ReadDir implements fs.ReadDirFS. Entries are sorted by name.
+func (x *FS) ReadDir(name string) ([]fs.DirEntry, error) {
+	info, err := x.stat("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.dir {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: errors.New("not a directory")}
+	}
+
+	res, err := x.readDir(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: err}
+	}
+
+	return res, nil
+}
+
+// stat resolves the named file or directory. Directories take precedence over
+// the files with the same name, and files with FilePath attribute take
+// precedence over the files with FileName attribute only. FilePath values are
+// matched with and without the leading slash.
+func (x *FS) stat(op, name string) (FileInfo, error) {
+	if !fs.ValidPath(name) {
+		return FileInfo{}, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+
+	if name == "." {
+		return FileInfo{name: ".", dir: true}, nil
+	}
+
+	ids, err := objects.SearchPath(x.ctx, x.s.SearchObjects, x.cnr, name+"/", object.MatchCommonPrefix)
+	if err != nil {
+		return FileInfo{}, &fs.PathError{Op: op, Path: name, Err: fmt.Errorf("search directory objects: %w", err)}
+	}
+
+	if len(ids) > 0 {
+		return FileInfo{name: baseName(name), dir: true}, nil
+	}
+
+	ids, err = objects.SearchPath(x.ctx, x.s.SearchObjects, x.cnr, name, object.MatchStringEqual)
+	if err != nil {
+		return FileInfo{}, &fs.PathError{Op: op, Path: name, Err: fmt.Errorf("search file objects: %w", err)}
+	}
+
+	info, found, err := x.newestFile(ids)
+	if err != nil {
+		return FileInfo{}, &fs.PathError{Op: op, Path: name, Err: err}
+	}
+
+	if !found && !strings.Contains(name, "/") {
+		var filters object.SearchFilters
+		filters.AddFilter(object.AttributeFileName, name, object.MatchStringEqual)
+		filters.AddFilter(object.AttributeFilePath, "", object.MatchNotPresent)
+
+		ids, err = x.s.SearchObjects(x.ctx, x.cnr, filters)
+		if err != nil {
+			return FileInfo{}, &fs.PathError{Op: op, Path: name, Err: fmt.Errorf("search file objects: %w", err)}
+		}
+
+		info, found, err = x.newestFile(ids)
+		if err != nil {
+			return FileInfo{}, &fs.PathError{Op: op, Path: name, Err: err}
+		}
+	}
+
+	if !found {
+		return FileInfo{}, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+	}
+
+	info.name = baseName(name)
+
+	return info, nil
+}
+
+// newestFile selects the newest of the referenced objects.
+func (x *FS) newestFile(ids []oid.ID) (FileInfo, bool, error) {
+	var (
+		res   FileInfo
+		found bool
+	)
+
+	for i := range ids {
+		info, err := x.fileInfo(ids[i])
+		if err != nil {
+			return FileInfo{}, false, err
+		}
+
+		if !found || newer(info, res) {
+			res, found = info, true
+		}
+	}
+
+	return res, found, nil
+}
+
+// fileInfo reads header of the referenced object and returns file
+// information without the name.
+func (x *FS) fileInfo(id oid.ID) (FileInfo, error) {
+	var res FileInfo
+
+	res.addr.SetContainer(x.cnr)
+	res.addr.SetObject(id)
+
+	var err error
+
+	res.hdr, err = x.s.HeadObject(x.ctx, res.addr)
+	if err != nil {
+		return FileInfo{}, fmt.Errorf("read header of the object %s: %w", id, err)
+	}
+
+	res.modTime = objects.Timestamp(res.hdr)
+
+	return res, nil
+}
+
+// readDir lists the named directory. The directory must exist.
+func (x *FS) readDir(name string) ([]fs.DirEntry, error) {
+	var prefix string
+	if name != "." {
+		prefix = name + "/"
+	}
+
+	ids, err := objects.SearchPath(x.ctx, x.s.SearchObjects, x.cnr, prefix, object.MatchCommonPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("search objects: %w", err)
+	}
+
+	dirs := make(map[string]struct{})
+	files := make(map[string]FileInfo)
+
+	for i := range ids {
+		info, err := x.fileInfo(ids[i])
+		if err != nil {
+			return nil, err
+		}
+
+		rest := strings.TrimPrefix(objects.FilePath(info.hdr), prefix)
+		if rest == "" {
+			// marker of the listed directory
+			continue
+		}
+
+		if ind := strings.IndexByte(rest, '/'); ind >= 0 {
+			if isValidElem(rest[:ind]) {
+				dirs[rest[:ind]] = struct{}{}
+			}
+
+			continue
+		}
+
+		if !isValidElem(rest) {
+			continue
+		}
+
+		if prev, ok := files[rest]; !ok || newer(info, prev) {
+			info.name = rest
+			files[rest] = info
+		}
+	}
+
+	if name == "." {
+		var filters object.SearchFilters
+		filters.AddFilter(object.AttributeFileName, "", object.MatchCommonPrefix)
+		filters.AddFilter(object.AttributeFilePath, "", object.MatchNotPresent)
+
+		ids, err = x.s.SearchObjects(x.ctx, x.cnr, filters)
+		if err != nil {
+			return nil, fmt.Errorf("search objects: %w", err)
+		}
+
+		pathFiles := make(map[string]struct{}, len(files))
+		for fileName := range files {
+			pathFiles[fileName] = struct{}{}
+		}
+
+		for i := range ids {
+			info, err := x.fileInfo(ids[i])
+			if err != nil {
+				return nil, err
+			}
+
+			fileName := objects.Attribute(info.hdr, object.AttributeFileName)
+			if !isValidElem(fileName) {
+				continue
+			}
+
+			if _, ok := pathFiles[fileName]; ok {
+				continue
+			}
+
+			if prev, ok := files[fileName]; !ok || newer(info, prev) {
+				info.name = fileName
+				files[fileName] = info
+			}
+		}
+	}
+
+	res := make([]fs.DirEntry, 0, len(dirs)+len(files))
+
+	for dirName := range dirs {
+		res = append(res, fs.FileInfoToDirEntry(FileInfo{name: dirName, dir: true}))
+	}
+
+	for fileName, info := range files {
+		if _, ok := dirs[fileName]; ok {
+			continue
+		}
+
+		res = append(res, fs.FileInfoToDirEntry(info))
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name() < res[j].Name()
+	})
+
+	return res, nil
+}
+
+// file is an opened regular file of the FS.
+type file struct {
+	fsys *FS
+
+	name string
+
+	info FileInfo
+
+	closed bool
+
+	off int64
+
+	r io.ReadCloser
+}
+
+func (x *file) Stat() (fs.FileInfo, error) {
+	if x.closed {
+		return nil, &fs.PathError{Op: "stat", Path: x.name, Err: fs.ErrClosed}
+	}
+
+	return x.info, nil
+}
+
+func (x *file) Read(p []byte) (int, error) {
+	if x.closed {
+		return 0, &fs.PathError{Op: "read", Path: x.name, Err: fs.ErrClosed}
+	}
+
+	if x.off >= x.info.Size() {
+		return 0, io.EOF
+	}
+
+	if x.r == nil {
+		var (
+			err error
+			ln  uint64
+		)
+
+		if x.off > 0 {
+			ln = uint64(x.info.Size() - x.off)
+		}
+
+		x.r, err = x.fsys.s.ObjectRange(x.fsys.ctx, x.info.addr, uint64(x.off), ln)
+		if err != nil {
+			return 0, &fs.PathError{Op: "read", Path: x.name, Err: err}
+		}
+	}
+
+	n, err := x.r.Read(p)
+	x.off += int64(n)
+
+	return n, err
+}
+
+// Seek implements io.Seeker. Payload is re-requested from the new offset
+// on the next read.
+func (x *file) Seek(offset int64, whence int) (int64, error) {
+	if x.closed {
+		return 0, &fs.PathError{Op: "seek", Path: x.name, Err: fs.ErrClosed}
+	}
+
+	switch whence {
+	default:
+		return 0, &fs.PathError{Op: "seek", Path: x.name, Err: fs.ErrInvalid}
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += x.off
+	case io.SeekEnd:
+		offset += x.info.Size()
+	}
+
+	if offset < 0 {
+		return 0, &fs.PathError{Op: "seek", Path: x.name, Err: fs.ErrInvalid}
+	}
+
+	if offset != x.off && x.r != nil {
+		_ = x.r.Close()
+		x.r = nil
+	}
+
+	x.off = offset
+
+	return offset, nil
+}
+
+func (x *file) Close() error {
+	if x.closed {
+		return &fs.PathError{Op: "close", Path: x.name, Err: fs.ErrClosed}
+	}
+
+	x.closed = true
+
+	if x.r != nil {
+		return x.r.Close()
+	}
+
+	return nil
+}
+
+// dir is an opened directory of the FS.
+type dir struct {
+	fsys *FS
+
+	name string
+
+	info FileInfo
+
+	closed bool
+
+	entries []fs.DirEntry
+	read    bool
+}
+
+func (x *dir) Stat() (fs.FileInfo, error) {
+	if x.closed {
+		return nil, &fs.PathError{Op: "stat", Path: x.name, Err: fs.ErrClosed}
+	}
+
+	return x.info, nil
+}
+
+func (x *dir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: x.name, Err: errors.New("is a directory")}
+}
+
+// ReadDir implements fs.ReadDirFile. Directory is listed on the first call.
+func (x *dir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if x.closed {
+		return nil, &fs.PathError{Op: "readdir", Path: x.name, Err: fs.ErrClosed}
+	}
+
+	if !x.read {
+		var err error
+
+		x.entries, err = x.fsys.readDir(x.name)
+		if err != nil {
+			return nil, &fs.PathError{Op: "readdir", Path: x.name, Err: err}
+		}
+
+		x.read = true
+	}
+
+	if n <= 0 {
+		res := x.entries
+		x.entries = nil
+
+		return res, nil
+	}
+
+	if len(x.entries) == 0 {
+		return nil, io.EOF
+	}
+
+	if n > len(x.entries) {
+		n = len(x.entries)
+	}
+
+	res := x.entries[:n]
+	x.entries = x.entries[n:]
+
+	return res, nil
+}
+
+func (x *dir) Close() error {
+	if x.closed {
+		return &fs.PathError{Op: "close", Path: x.name, Err: fs.ErrClosed}
+	}
+
+	x.closed = true
+
+	return nil
+}
+
+// newer checks if file a is newer than b.
+func newer(a, b FileInfo) bool {
+	return objects.Newer(a.addr.Object(), a.modTime, b.addr.Object(), b.modTime)
+}
+
+// baseName returns the last element of the valid path.
+func baseName(name string) string {
+	return name[strings.LastIndexByte(name, '/')+1:]
+}
+
+// isValidElem checks if s is a valid path element.
+func isValidElem(s string) bool {
+	return s != "" && s != "." && s != ".." && !strings.Contains(s, "/")
+}
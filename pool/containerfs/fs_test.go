@@ -0,0 +1,126 @@
+package containerfs_test
+
+import (
+	"context"
+	"io/fs"
+	"strconv"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/pool/containerfs"
+	objectstest "github.com/nspcc-dev/neofs-sdk-go/pool/internal/objects/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFS(t *testing.T) {
+	var s objectstest.Storage
+
+	ts := time.Now().Add(-time.Hour).Unix()
+	tsStr := strconv.FormatInt(ts, 10)
+
+	s.Add("root file", object.AttributeFilePath, "/root.txt", object.AttributeTimestamp, tsStr)
+	s.Add("named file", object.AttributeFileName, "named.txt")
+	s.Add("old version", object.AttributeFilePath, "/dir/file.txt", object.AttributeTimestamp, "1")
+	s.Add("new version", object.AttributeFilePath, "/dir/file.txt", object.AttributeTimestamp, tsStr,
+		object.AttributeContentType, "text/plain")
+	s.Add("", object.AttributeFilePath, "/dir/empty/")
+	s.Add("deep", object.AttributeFilePath, "/dir/sub/deep.bin")
+	// directory takes precedence over the file with the same name
+	s.Add("shadowed", object.AttributeFilePath, "/dir/sub")
+	// file path takes precedence over the file name
+	s.Add("shadowed", object.AttributeFileName, "root.txt")
+	// leading slash is optional
+	s.Add("relative", object.AttributeFilePath, "rel/file.txt")
+	s.Add("mixed", object.AttributeFilePath, "dir/sub/mixed.txt")
+	// invalid path elements are skipped
+	s.Add("invalid", object.AttributeFilePath, "/dir/../escape")
+
+	fsys := containerfs.New(context.Background(), &s, cidtest.ID())
+
+	require.NoError(t, fstest.TestFS(fsys,
+		"root.txt",
+		"named.txt",
+		"dir/file.txt",
+		"dir/empty",
+		"dir/sub/deep.bin",
+		"dir/sub/mixed.txt",
+		"rel/file.txt",
+	))
+
+	data, err := fs.ReadFile(fsys, "dir/file.txt")
+	require.NoError(t, err)
+	require.Equal(t, "new version", string(data))
+
+	info, err := fs.Stat(fsys, "dir/file.txt")
+	require.NoError(t, err)
+	require.Equal(t, "file.txt", info.Name())
+	require.EqualValues(t, len("new version"), info.Size())
+	require.Equal(t, time.Unix(ts, 0), info.ModTime())
+	require.Equal(t, "text/plain", info.(containerfs.FileInfo).ContentType())
+
+	_, ok := info.(containerfs.FileInfo).Address()
+	require.True(t, ok)
+
+	info, err = fs.Stat(fsys, "dir/sub")
+	require.NoError(t, err)
+	require.True(t, info.IsDir())
+
+	data, err = fs.ReadFile(fsys, "root.txt")
+	require.NoError(t, err)
+	require.Equal(t, "root file", string(data))
+
+	entries, err := fs.ReadDir(fsys, "dir")
+	require.NoError(t, err)
+
+	var names []string
+	for i := range entries {
+		names = append(names, entries[i].Name())
+	}
+
+	require.Equal(t, []string{"empty", "file.txt", "sub"}, names)
+
+	entries, err = fs.ReadDir(fsys, "dir/sub")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "deep.bin", entries[0].Name())
+	require.Equal(t, "mixed.txt", entries[1].Name())
+
+	data, err = fs.ReadFile(fsys, "rel/file.txt")
+	require.NoError(t, err)
+	require.Equal(t, "relative", string(data))
+
+	info, err = fs.Stat(fsys, "rel")
+	require.NoError(t, err)
+	require.True(t, info.IsDir())
+
+	_, err = fsys.Open("missing")
+	require.ErrorIs(t, err, fs.ErrNotExist)
+
+	_, err = fsys.Open("/root.txt")
+	require.ErrorIs(t, err, fs.ErrInvalid)
+
+	_, err = fsys.ReadDir("root.txt")
+	require.Error(t, err)
+}
+
+func TestFS_LeadingSlash(t *testing.T) {
+	var s objectstest.Storage
+
+	s.Add("old", object.AttributeFilePath, "/file.txt", object.AttributeTimestamp, "1")
+	s.Add("new", object.AttributeFilePath, "file.txt", object.AttributeTimestamp, "2")
+
+	fsys := containerfs.New(context.Background(), &s, cidtest.ID())
+
+	// both forms refer to the same file, the newest one is served
+	data, err := fs.ReadFile(fsys, "file.txt")
+	require.NoError(t, err)
+	require.Equal(t, "new", string(data))
+
+	entries, err := fs.ReadDir(fsys, ".")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "file.txt", entries[0].Name())
+}
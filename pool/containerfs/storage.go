@@ -0,0 +1,32 @@
+package containerfs
+
+import (
+	"context"
+	"io"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/pool"
+	"github.com/nspcc-dev/neofs-sdk-go/pool/internal/objects"
+)
+
+// Storage is an interface of NeoFS object storage used by FS.
+type Storage interface {
+	// SearchObjects returns identifiers of the container objects matching
+	// the given filters.
+	SearchObjects(ctx context.Context, cnrID cid.ID, filters object.SearchFilters) ([]oid.ID, error)
+
+	// HeadObject returns header of the referenced object.
+	HeadObject(ctx context.Context, addr oid.Address) (object.Object, error)
+
+	// ObjectRange opens payload range of the referenced object. Zero offset
+	// and length mean the whole payload.
+	ObjectRange(ctx context.Context, addr oid.Address, off, ln uint64) (io.ReadCloser, error)
+}
+
+// NewFromPool returns FS of the referenced container working through the
+// given pool.Pool. Context is used for all network operations.
+func NewFromPool(ctx context.Context, p *pool.Pool, cnrID cid.ID) *FS {
+	return New(ctx, objects.NewPool(p), cnrID)
+}
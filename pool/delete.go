@@ -0,0 +1,120 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/deletion"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/relations"
+)
+
+// PrmObjectDeleteAll groups parameters of DeleteAllObjects operation.
+type PrmObjectDeleteAll struct {
+	prmCommon
+
+	cnrID cid.ID
+
+	ids []oid.ID
+
+	prm deletion.Prm
+}
+
+// SetContainerID specifies the container of the removed objects.
+func (x *PrmObjectDeleteAll) SetContainerID(cnrID cid.ID) {
+	x.cnrID = cnrID
+}
+
+// SetObjects specifies identifiers of the objects to be removed.
+func (x *PrmObjectDeleteAll) SetObjects(ids ...oid.ID) {
+	x.ids = ids
+}
+
+// SetTombstoneLifetime sets number of epochs after the current one during
+// which tombstones are stored. Defaults to deletion.DefaultTombstoneLifetime.
+func (x *PrmObjectDeleteAll) SetTombstoneLifetime(epochs uint64) {
+	x.prm.SetTombstoneLifetime(epochs)
+}
+
+// SetTombstoneExpirationEpoch sets the last epoch of the tombstones lifetime.
+// Overrides SetTombstoneLifetime.
+func (x *PrmObjectDeleteAll) SetTombstoneExpirationEpoch(epoch uint64) {
+	x.prm.SetExpirationEpoch(epoch)
+}
+
+// SetMaxTombstoneMembers limits number of objects per tombstone. Defaults to
+// deletion.DefaultMaxMembers.
+func (x *PrmObjectDeleteAll) SetMaxTombstoneMembers(n int) {
+	x.prm.SetMaxMembers(n)
+}
+
+// DeleteAllObjects removes the objects from the container along with all
+// physical objects of their split hierarchies (see deletion.Delete). Unlike
+// DeleteObject, multiple objects are grouped into single tombstone which is
+// stored through PutObject.
+//
+// Outcome of each object is returned in the order of requested objects, error
+// is returned only if the operation could not be started.
+//
+// Main return value MUST NOT be processed on an erroneous return.
+func (p *Pool) DeleteAllObjects(ctx context.Context, prm PrmObjectDeleteAll) ([]deletion.Result, error) {
+	if len(prm.ids) == 0 {
+		return nil, errors.New("missing objects to delete")
+	}
+
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	prm.prm.SetTokens(relations.Tokens{
+		Session: prm.stoken,
+		Bearer:  prm.btoken,
+	})
+
+	return deletion.Delete(ctx, deletionStorage{
+		poolRelations: poolRelations{p},
+		prm:           prm.prmCommon,
+	}, prm.cnrID, prm.ids, prm.prm)
+}
+
+// deletionStorage implements deletion.Storage through the Pool without the
+// operation tracking.
+type deletionStorage struct {
+	poolRelations
+
+	prm prmCommon
+}
+
+// CurrentEpoch implements deletion.Storage.
+func (x deletionStorage) CurrentEpoch(ctx context.Context) (uint64, error) {
+	ni, err := x.p.networkInfo(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("network info: %w", err)
+	}
+
+	return ni.CurrentEpoch(), nil
+}
+
+// PutTombstone implements deletion.Storage.
+func (x deletionStorage) PutTombstone(ctx context.Context, cnrID cid.ID, ts object.Tombstone, tokens relations.Tokens) (oid.ID, error) {
+	payload, err := ts.Marshal()
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("encode tombstone: %w", err)
+	}
+
+	prm := x.prm
+	prm.btoken = tokens.Bearer
+	prm.stoken = tokens.Session
+
+	id, err := x.p.putSystemObject(ctx, prm, cnrID, object.TypeTombstone, payload, ts.ExpirationEpoch())
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("write TOMBSTONE object: %w", err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,85 @@
+package pool
+
+import (
+	"context"
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/deletion"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool_DeleteAllObjects(t *testing.T) {
+	mockCli := newMockClient("peer0", *newPrivateKey(t))
+
+	opts := InitParameters{
+		key:        newPrivateKey(t),
+		nodeParams: []NodeParam{{1, "peer0", 1}},
+	}
+	opts.setClientBuilder(func(string) client {
+		return mockCli
+	})
+
+	p, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, p.Dial(context.Background()))
+	t.Cleanup(p.Close)
+
+	cnr := cidtest.ID()
+	ids := []oid.ID{oidtest.ID(), oidtest.ID(), oidtest.ID()}
+
+	var prm PrmObjectDeleteAll
+	prm.SetContainerID(cnr)
+
+	_, err = p.DeleteAllObjects(context.Background(), prm)
+	require.Error(t, err)
+
+	prm.SetObjects(ids...)
+	prm.SetMaxTombstoneMembers(2)
+
+	res, err := p.DeleteAllObjects(context.Background(), prm)
+	require.NoError(t, err)
+	require.Len(t, res, len(ids))
+
+	for i := range res {
+		require.Equal(t, ids[i], res[i].Object())
+		require.Equal(t, deletion.StatusRemoved, res[i].Status())
+	}
+
+	// objects are grouped into tombstones of at most 2 members
+	require.Len(t, mockCli.putHeaders, 2)
+
+	var members []oid.ID
+
+	for _, hdr := range mockCli.putHeaders {
+		require.Equal(t, object.TypeTombstone, hdr.Type())
+
+		hdrCnr, ok := hdr.ContainerID()
+		require.True(t, ok)
+		require.Equal(t, cnr, hdrCnr)
+
+		var ts object.Tombstone
+		require.NoError(t, ts.Unmarshal(hdr.Payload()))
+		// mocked network info returns zero epoch
+		require.EqualValues(t, deletion.DefaultTombstoneLifetime, ts.ExpirationEpoch())
+
+		members = append(members, ts.Members()...)
+	}
+
+	require.Equal(t, ids, members)
+
+	mockCli.putHeaders = nil
+	prm.SetTombstoneExpirationEpoch(42)
+
+	_, err = p.DeleteAllObjects(context.Background(), prm)
+	require.NoError(t, err)
+
+	for _, hdr := range mockCli.putHeaders {
+		var ts object.Tombstone
+		require.NoError(t, ts.Unmarshal(hdr.Payload()))
+		require.EqualValues(t, 42, ts.ExpirationEpoch())
+	}
+}
@@ -0,0 +1,646 @@
+package dirsync
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/pool/internal/objects"
+)
+
+// AttributeFileMode is an attribute key of the file permission bits in octal
+// format.
+const AttributeFileMode = "FileMode"
+
+// DefaultParallelism is a default number of simultaneously transferred files.
+const DefaultParallelism = 4
+
+// Prm groups parameters of Upload and Download operations.
+type Prm struct {
+	parallelism int
+
+	prefix string
+
+	deleteExtra bool
+
+	dryRun bool
+}
+
+// SetParallelism sets number of simultaneously transferred files.
+// Non-positive value means DefaultParallelism.
+func (x *Prm) SetParallelism(n int) {
+	x.parallelism = n
+}
+
+// SetPathPrefix sets path of the container directory to synchronize with.
+// Defaults to the root directory.
+func (x *Prm) SetPathPrefix(prefix string) {
+	x.prefix = prefix
+}
+
+// DeleteExtra makes the operation to remove files missing in the source,
+// including outdated versions of the container files. Files are removed
+// only if all transfers succeed.
+func (x *Prm) DeleteExtra() {
+	x.deleteExtra = true
+}
+
+// DryRun makes the operation to only report planned actions without
+// executing them.
+func (x *Prm) DryRun() {
+	x.dryRun = true
+}
+
+// ActionType enumerates synchronization actions.
+type ActionType uint8
+
+const (
+	_ ActionType = iota
+
+	// ActionSkip is an action of the unchanged file.
+	ActionSkip
+
+	// ActionUpload is an action of the local file storing in the container.
+	ActionUpload
+
+	// ActionDownload is an action of the container file saving in the local
+	// directory.
+	ActionDownload
+
+	// ActionDelete is an action of the extra file removal.
+	ActionDelete
+)
+
+// String implements fmt.Stringer.
+func (x ActionType) String() string {
+	switch x {
+	default:
+		return fmt.Sprintf("UNKNOWN#%d", x)
+	case ActionSkip:
+		return "SKIP"
+	case ActionUpload:
+		return "UPLOAD"
+	case ActionDownload:
+		return "DOWNLOAD"
+	case ActionDelete:
+		return "DELETE"
+	}
+}
+
+// Action describes planned or executed synchronization action.
+type Action struct {
+	typ ActionType
+
+	path string
+
+	size int64
+
+	addrSet bool
+	addr    oid.Address
+
+	err error
+}
+
+// Type returns action type.
+func (x Action) Type() ActionType {
+	return x.typ
+}
+
+// Path returns slash-separated path of the file relative to the synchronized
+// directories.
+func (x Action) Path() string {
+	return x.path
+}
+
+// Size returns size of the transferred file.
+func (x Action) Size() int64 {
+	return x.size
+}
+
+// Address returns address of the container object the action relates to.
+// For ActionUpload, returns address of the stored object after execution.
+// Returns false if address is unknown.
+func (x Action) Address() (oid.Address, bool) {
+	return x.addr, x.addrSet
+}
+
+// Err returns error of the action execution.
+func (x Action) Err() error {
+	return x.err
+}
+
+// Report describes result of the synchronization.
+type Report struct {
+	actions []Action
+}
+
+// Actions returns all actions of the synchronization. Transfer actions
+// precede removals, and actions of each kind are sorted by path.
+func (x Report) Actions() []Action {
+	return x.actions
+}
+
+// Err returns joint error of the failed actions. Returns nil if all
+// actions succeeded.
+func (x Report) Err() error {
+	var (
+		n     int
+		first error
+	)
+
+	for i := range x.actions {
+		if x.actions[i].err != nil {
+			if n == 0 {
+				first = fmt.Errorf("%s %s: %w", x.actions[i].typ, x.actions[i].path, x.actions[i].err)
+			}
+
+			n++
+		}
+	}
+
+	if n > 1 {
+		return fmt.Errorf("%d actions failed, first: %w", n, first)
+	}
+
+	return first
+}
+
+// remoteFile describes container object representing the file.
+type remoteFile struct {
+	path string
+
+	addr oid.Address
+
+	hdr object.Object
+
+	modTime time.Time
+}
+
+// localFile describes file in the local directory.
+type localFile struct {
+	path string
+
+	fullPath string
+
+	info fs.FileInfo
+}
+
+// Upload synchronizes container directory with the local one. Files are
+// stored with the FilePath, FileName, Timestamp (modification time),
+// Content-Type and FileMode attributes. Files with the same size and SHA256
+// checksum as in the container are skipped.
+//
+// Returns an error if the synchronization could not be planned. Execution
+// errors are reported per action (see Report.Err).
+func Upload(ctx context.Context, s Storage, cnrID cid.ID, dir string, prm Prm) (Report, error) {
+	prefix := normalizePrefix(prm.prefix)
+
+	remote, outdated, err := listRemote(ctx, s, cnrID, prefix)
+	if err != nil {
+		return Report{}, err
+	}
+
+	local, err := listLocal(dir)
+	if err != nil {
+		return Report{}, err
+	}
+
+	var transfers, removals []Action
+
+	for p, lf := range local {
+		a := Action{
+			typ:  ActionUpload,
+			path: p,
+			size: lf.info.Size(),
+		}
+
+		if rf, ok := remote[p]; ok {
+			same, err := sameContent(lf, rf.hdr)
+			if err != nil {
+				return Report{}, err
+			}
+
+			if same {
+				a.typ = ActionSkip
+				a.addr, a.addrSet = rf.addr, true
+			} else if prm.deleteExtra {
+				outdated = append(outdated, rf)
+			}
+		}
+
+		transfers = append(transfers, a)
+	}
+
+	if prm.deleteExtra {
+		for p, rf := range remote {
+			if _, ok := local[p]; !ok {
+				outdated = append(outdated, rf)
+			}
+		}
+
+		for i := range outdated {
+			removals = append(removals, Action{
+				typ:     ActionDelete,
+				path:    outdated[i].path,
+				addr:    outdated[i].addr,
+				addrSet: true,
+			})
+		}
+	}
+
+	sortActions(transfers)
+	sortActions(removals)
+
+	if !prm.dryRun {
+		execute(transfers, prm.parallelism, func(a *Action) {
+			lf := local[a.path]
+
+			a.addr, a.err = uploadFile(ctx, s, cnrID, prefix, lf)
+			a.addrSet = a.err == nil
+		})
+
+		if failed(transfers) {
+			// keep outdated versions if not all files are uploaded
+			removals = nil
+		}
+
+		execute(removals, prm.parallelism, func(a *Action) {
+			a.err = s.DeleteObject(ctx, a.addr)
+		})
+	}
+
+	return Report{actions: append(transfers, removals...)}, nil
+}
+
+// Download synchronizes local directory with the container one. Files are
+// saved with the permissions and modification time from the FileMode and
+// Timestamp attributes. Files with the same size and SHA256 checksum as in
+// the container are skipped.
+//
+// Returns an error if the synchronization could not be planned. Execution
+// errors are reported per action (see Report.Err).
+func Download(ctx context.Context, s Storage, cnrID cid.ID, dir string, prm Prm) (Report, error) {
+	prefix := normalizePrefix(prm.prefix)
+
+	remote, _, err := listRemote(ctx, s, cnrID, prefix)
+	if err != nil {
+		return Report{}, err
+	}
+
+	local, err := listLocal(dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return Report{}, err
+	}
+
+	var transfers, removals []Action
+
+	for p, rf := range remote {
+		a := Action{
+			typ:     ActionDownload,
+			path:    p,
+			size:    int64(rf.hdr.PayloadSize()),
+			addr:    rf.addr,
+			addrSet: true,
+		}
+
+		if lf, ok := local[p]; ok {
+			same, err := sameContent(lf, rf.hdr)
+			if err != nil {
+				return Report{}, err
+			}
+
+			if same {
+				a.typ = ActionSkip
+			}
+		}
+
+		transfers = append(transfers, a)
+	}
+
+	if prm.deleteExtra {
+		for p := range local {
+			if _, ok := remote[p]; !ok {
+				removals = append(removals, Action{
+					typ:  ActionDelete,
+					path: p,
+				})
+			}
+		}
+	}
+
+	sortActions(transfers)
+	sortActions(removals)
+
+	if !prm.dryRun {
+		execute(transfers, prm.parallelism, func(a *Action) {
+			a.err = downloadFile(ctx, s, remote[a.path], filepath.Join(dir, filepath.FromSlash(a.path)))
+		})
+
+		if failed(transfers) {
+			// keep extra local files if not all files are downloaded
+			removals = nil
+		}
+
+		execute(removals, prm.parallelism, func(a *Action) {
+			a.err = os.Remove(local[a.path].fullPath)
+		})
+	}
+
+	return Report{actions: append(transfers, removals...)}, nil
+}
+
+// normalizePrefix returns container path prefix with leading and trailing
+// slashes.
+func normalizePrefix(prefix string) string {
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return "/"
+	}
+
+	return "/" + prefix + "/"
+}
+
+// listRemote lists container files under the given prefix. FilePath values
+// are matched with and without the leading slash. Returns the newest object
+// for each path and all outdated versions.
+func listRemote(ctx context.Context, s Storage, cnrID cid.ID, prefix string) (map[string]remoteFile, []remoteFile, error) {
+	prefix = strings.TrimPrefix(prefix, "/")
+
+	ids, err := objects.SearchPath(ctx, s.SearchObjects, cnrID, prefix, object.MatchCommonPrefix)
+	if err != nil {
+		return nil, nil, fmt.Errorf("search container files: %w", err)
+	}
+
+	var (
+		res      = make(map[string]remoteFile, len(ids))
+		outdated []remoteFile
+	)
+
+	for i := range ids {
+		var rf remoteFile
+		rf.addr.SetContainer(cnrID)
+		rf.addr.SetObject(ids[i])
+
+		rf.hdr, err = s.HeadObject(ctx, rf.addr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read header of the object %s: %w", ids[i], err)
+		}
+
+		rf.path = strings.TrimPrefix(objects.FilePath(rf.hdr), prefix)
+		if !fs.ValidPath(rf.path) || rf.path == "." {
+			// directory markers and invalid paths
+			continue
+		}
+
+		rf.modTime = objects.Timestamp(rf.hdr)
+
+		prev, ok := res[rf.path]
+		if !ok {
+			res[rf.path] = rf
+			continue
+		}
+
+		if newer(rf, prev) {
+			res[rf.path] = rf
+			rf = prev
+		}
+
+		outdated = append(outdated, rf)
+	}
+
+	return res, outdated, nil
+}
+
+// listLocal lists regular files of the local directory.
+func listLocal(dir string) (map[string]localFile, error) {
+	res := make(map[string]localFile)
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		res[rel] = localFile{
+			path:     rel,
+			fullPath: p,
+			info:     info,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk local directory: %w", err)
+	}
+
+	return res, nil
+}
+
+// sameContent checks if local file has the same size and SHA256 checksum as
+// the object payload.
+func sameContent(lf localFile, hdr object.Object) (bool, error) {
+	if uint64(lf.info.Size()) != hdr.PayloadSize() {
+		return false, nil
+	}
+
+	cs, ok := hdr.PayloadChecksum()
+	if !ok || cs.Type() != checksum.SHA256 {
+		return false, nil
+	}
+
+	f, err := os.Open(lf.fullPath)
+	if err != nil {
+		return false, fmt.Errorf("open local file: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return false, fmt.Errorf("hash local file: %w", err)
+	}
+
+	return bytes.Equal(h.Sum(nil), cs.Value()), nil
+}
+
+// uploadFile stores the local file in the container.
+func uploadFile(ctx context.Context, s Storage, cnrID cid.ID, prefix string, lf localFile) (oid.Address, error) {
+	f, err := os.Open(lf.fullPath)
+	if err != nil {
+		return oid.Address{}, err
+	}
+	defer f.Close()
+
+	attrs := []string{
+		object.AttributeFilePath, prefix + lf.path,
+		object.AttributeFileName, path.Base(lf.path),
+		object.AttributeTimestamp, strconv.FormatInt(lf.info.ModTime().Unix(), 10),
+		AttributeFileMode, strconv.FormatUint(uint64(lf.info.Mode().Perm()), 8),
+	}
+
+	if ct := mime.TypeByExtension(path.Ext(lf.path)); ct != "" {
+		attrs = append(attrs, object.AttributeContentType, ct)
+	}
+
+	as := make([]object.Attribute, len(attrs)/2)
+	for i := range as {
+		as[i].SetKey(attrs[2*i])
+		as[i].SetValue(attrs[2*i+1])
+	}
+
+	var hdr object.Object
+	hdr.SetContainerID(cnrID)
+	hdr.SetPayloadSize(uint64(lf.info.Size()))
+	hdr.SetAttributes(as...)
+
+	id, err := s.PutObject(ctx, hdr, f)
+	if err != nil {
+		return oid.Address{}, err
+	}
+
+	var addr oid.Address
+	addr.SetContainer(cnrID)
+	addr.SetObject(id)
+
+	return addr, nil
+}
+
+// downloadFile saves payload of the container file into the local file.
+// Payload is written to the temporary file which replaces the target one
+// after success.
+func downloadFile(ctx context.Context, s Storage, rf remoteFile, target string) error {
+	err := os.MkdirAll(filepath.Dir(target), 0755)
+	if err != nil {
+		return err
+	}
+
+	mode := fs.FileMode(0644)
+
+	if v := objects.Attribute(rf.hdr, AttributeFileMode); v != "" {
+		if m, err := strconv.ParseUint(v, 8, 32); err == nil {
+			mode = fs.FileMode(m).Perm()
+		}
+	}
+
+	payload, err := s.GetObject(ctx, rf.addr)
+	if err != nil {
+		return err
+	}
+	defer payload.Close()
+
+	f, err := os.CreateTemp(filepath.Dir(target), ".dirsync-*")
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, payload)
+	if err == nil {
+		err = f.Chmod(mode)
+	}
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil && !rf.modTime.IsZero() {
+		err = os.Chtimes(f.Name(), rf.modTime, rf.modTime)
+	}
+
+	if err == nil {
+		err = os.Rename(f.Name(), target)
+	}
+
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+
+	return err
+}
+
+// execute calls f for each action except skipped ones using n routines.
+func execute(actions []Action, n int, f func(*Action)) {
+	if n <= 0 {
+		n = DefaultParallelism
+	}
+
+	var (
+		wg sync.WaitGroup
+		ch = make(chan *Action)
+	)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for a := range ch {
+				f(a)
+			}
+		}()
+	}
+
+	for i := range actions {
+		if actions[i].typ != ActionSkip {
+			ch <- &actions[i]
+		}
+	}
+
+	close(ch)
+	wg.Wait()
+}
+
+// failed checks if any of the actions failed.
+func failed(actions []Action) bool {
+	for i := range actions {
+		if actions[i].err != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortActions(actions []Action) {
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].path < actions[j].path
+	})
+}
+
+// newer checks if file a is newer than b.
+func newer(a, b remoteFile) bool {
+	return objects.Newer(a.addr.Object(), a.modTime, b.addr.Object(), b.modTime)
+}
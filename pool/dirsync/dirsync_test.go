@@ -0,0 +1,203 @@
+package dirsync_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/pool/dirsync"
+	objectstest "github.com/nspcc-dev/neofs-sdk-go/pool/internal/objects/test"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, dir, name, data string, mode os.FileMode) {
+	p := filepath.Join(dir, filepath.FromSlash(name))
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+	require.NoError(t, os.WriteFile(p, []byte(data), mode))
+}
+
+func actionTypes(rep dirsync.Report) map[string]dirsync.ActionType {
+	res := make(map[string]dirsync.ActionType)
+
+	for _, a := range rep.Actions() {
+		res[a.Path()] = a.Type()
+	}
+
+	return res
+}
+
+func TestSync(t *testing.T) {
+	var (
+		ctx = context.Background()
+		s   objectstest.Storage
+		cnr = cidtest.ID()
+		src = t.TempDir()
+		dst = t.TempDir()
+		prm dirsync.Prm
+	)
+
+	writeFile(t, src, "a.txt", "a", 0644)
+	writeFile(t, src, "dir/b.json", "b", 0600)
+
+	mtime := time.Unix(time.Now().Add(-time.Hour).Unix(), 0)
+	require.NoError(t, os.Chtimes(filepath.Join(src, "a.txt"), mtime, mtime))
+
+	prm.SetPathPrefix("/site")
+	prm.SetParallelism(2)
+
+	rep, err := dirsync.Upload(ctx, &s, cnr, src, prm)
+	require.NoError(t, err)
+	require.NoError(t, rep.Err())
+	require.Equal(t, map[string]dirsync.ActionType{
+		"a.txt":      dirsync.ActionUpload,
+		"dir/b.json": dirsync.ActionUpload,
+	}, actionTypes(rep))
+	require.Equal(t, 2, s.Puts())
+
+	for _, obj := range s.Objects() {
+		for _, a := range obj.Attributes() {
+			if a.Key() == object.AttributeFilePath {
+				require.True(t, strings.HasPrefix(a.Value(), "/site/"))
+			}
+		}
+	}
+
+	// unchanged files are skipped
+	rep, err = dirsync.Upload(ctx, &s, cnr, src, prm)
+	require.NoError(t, err)
+	require.Equal(t, map[string]dirsync.ActionType{
+		"a.txt":      dirsync.ActionSkip,
+		"dir/b.json": dirsync.ActionSkip,
+	}, actionTypes(rep))
+	require.Equal(t, 2, s.Puts())
+
+	rep, err = dirsync.Download(ctx, &s, cnr, dst, prm)
+	require.NoError(t, err)
+	require.NoError(t, rep.Err())
+
+	data, err := os.ReadFile(filepath.Join(dst, "dir", "b.json"))
+	require.NoError(t, err)
+	require.Equal(t, "b", string(data))
+
+	info, err := os.Stat(filepath.Join(dst, "a.txt"))
+	require.NoError(t, err)
+	require.True(t, info.ModTime().Equal(mtime))
+
+	if filepath.Separator == '/' {
+		info, err = os.Stat(filepath.Join(dst, "dir", "b.json"))
+		require.NoError(t, err)
+		require.EqualValues(t, 0600, info.Mode().Perm())
+	}
+
+	// change, remove and check dry run first
+	writeFile(t, src, "a.txt", "changed", 0644)
+	require.NoError(t, os.Remove(filepath.Join(src, "dir", "b.json")))
+
+	prm.DeleteExtra()
+
+	dryPrm := prm
+	dryPrm.DryRun()
+
+	rep, err = dirsync.Upload(ctx, &s, cnr, src, dryPrm)
+	require.NoError(t, err)
+	require.Len(t, rep.Actions(), 3) // upload of a.txt, removal of outdated a.txt and b.json
+	require.Equal(t, 2, s.Puts())
+	require.Zero(t, s.Deletes())
+
+	rep, err = dirsync.Upload(ctx, &s, cnr, src, prm)
+	require.NoError(t, err)
+	require.NoError(t, rep.Err())
+	require.Equal(t, 3, s.Puts())
+	require.Equal(t, 2, s.Deletes())
+	require.Len(t, s.Objects(), 1)
+
+	// extra local file is removed on download
+	writeFile(t, dst, "extra", "x", 0644)
+
+	rep, err = dirsync.Download(ctx, &s, cnr, dst, prm)
+	require.NoError(t, err)
+	require.NoError(t, rep.Err())
+	require.Equal(t, map[string]dirsync.ActionType{
+		"a.txt":      dirsync.ActionDownload,
+		"dir/b.json": dirsync.ActionDelete,
+		"extra":      dirsync.ActionDelete,
+	}, actionTypes(rep))
+
+	data, err = os.ReadFile(filepath.Join(dst, "a.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "changed", string(data))
+
+	_, err = os.Stat(filepath.Join(dst, "extra"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestDownload_RelativePath(t *testing.T) {
+	var (
+		s   objectstest.Storage
+		dst = t.TempDir()
+		prm dirsync.Prm
+	)
+
+	s.Add("a", object.AttributeFilePath, "site/a.txt")
+	s.Add("b", object.AttributeFilePath, "/site/dir/b.txt")
+	s.Add("c", object.AttributeFilePath, "other/c.txt")
+	writeFile(t, dst, "extra", "x", 0644)
+
+	prm.SetPathPrefix("site")
+	prm.DeleteExtra()
+
+	rep, err := dirsync.Download(context.Background(), &s, cidtest.ID(), dst, prm)
+	require.NoError(t, err)
+	require.NoError(t, rep.Err())
+	require.Equal(t, map[string]dirsync.ActionType{
+		"a.txt":     dirsync.ActionDownload,
+		"dir/b.txt": dirsync.ActionDownload,
+		"extra":     dirsync.ActionDelete,
+	}, actionTypes(rep))
+
+	data, err := os.ReadFile(filepath.Join(dst, "a.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "a", string(data))
+}
+
+// failingStorage fails all downloads.
+type failingStorage struct {
+	*objectstest.Storage
+}
+
+func (failingStorage) GetObject(context.Context, oid.Address) (io.ReadCloser, error) {
+	return nil, errors.New("any error")
+}
+
+func TestDownload_Failure(t *testing.T) {
+	var (
+		s   objectstest.Storage
+		dst = t.TempDir()
+		prm dirsync.Prm
+	)
+
+	s.Add("a", object.AttributeFilePath, "/a.txt")
+	writeFile(t, dst, "extra", "x", 0644)
+
+	prm.DeleteExtra()
+
+	rep, err := dirsync.Download(context.Background(), failingStorage{&s}, cidtest.ID(), dst, prm)
+	require.NoError(t, err)
+	require.Error(t, rep.Err())
+
+	// extra local files are kept if not all files are downloaded
+	require.Equal(t, map[string]dirsync.ActionType{
+		"a.txt": dirsync.ActionDownload,
+	}, actionTypes(rep))
+
+	_, err = os.Stat(filepath.Join(dst, "extra"))
+	require.NoError(t, err)
+}
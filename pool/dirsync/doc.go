@@ -0,0 +1,30 @@
+/*
+Package dirsync provides synchronization of the local directories with NeoFS
+containers.
+
+Upload stores files of the local directory tree in the container as objects
+with FilePath attributes, and Download saves container files into the local
+directory. Unchanged files are detected by SHA256 checksum and skipped.
+FilePath values are written with the leading slash and matched with and
+without it, so both "/dir/file.txt" and "dir/file.txt" refer to the same file.
+Storage is an interface of the NeoFS objects storage, use NewPoolStorage to
+work through pool.Pool.
+
+Mirror local directory into the container:
+
+	var prm dirsync.Prm
+	prm.SetPathPrefix("/site")
+	prm.DeleteExtra()
+
+	rep, err := dirsync.Upload(ctx, dirsync.NewPoolStorage(p, owner), cnrID, "./public", prm)
+	if err != nil {
+		// ...
+	}
+
+	if err = rep.Err(); err != nil {
+		// some files were not synchronized
+	}
+
+Use Prm.DryRun to report planned actions without executing them.
+*/
+package dirsync
@@ -0,0 +1,70 @@
+package dirsync
+
+import (
+	"context"
+	"io"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/pool"
+	"github.com/nspcc-dev/neofs-sdk-go/pool/internal/objects"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// Storage is an interface of NeoFS object storage used for synchronization.
+type Storage interface {
+	// SearchObjects returns identifiers of the container objects matching
+	// the given filters.
+	SearchObjects(ctx context.Context, cnrID cid.ID, filters object.SearchFilters) ([]oid.ID, error)
+
+	// HeadObject returns header of the referenced object.
+	HeadObject(ctx context.Context, addr oid.Address) (object.Object, error)
+
+	// GetObject opens payload of the referenced object.
+	GetObject(ctx context.Context, addr oid.Address) (io.ReadCloser, error)
+
+	// PutObject stores object with the given header and payload. Header
+	// contains container and attributes only, all other fields must be set
+	// by the Storage.
+	PutObject(ctx context.Context, hdr object.Object, payload io.Reader) (oid.ID, error)
+
+	// DeleteObject removes the referenced object.
+	DeleteObject(ctx context.Context, addr oid.Address) error
+}
+
+// NewPoolStorage returns Storage working through the given pool.Pool. Owner
+// is set to all stored objects and must correspond to the Pool key.
+func NewPoolStorage(p *pool.Pool, owner user.ID) Storage {
+	return poolStorage{
+		Pool:  objects.NewPool(p),
+		p:     p,
+		owner: owner,
+	}
+}
+
+// poolStorage implements Storage through pool.Pool.
+type poolStorage struct {
+	objects.Pool
+
+	p *pool.Pool
+
+	owner user.ID
+}
+
+func (x poolStorage) PutObject(ctx context.Context, hdr object.Object, payload io.Reader) (oid.ID, error) {
+	hdr.SetOwnerID(&x.owner)
+
+	var prm pool.PrmObjectPut
+	prm.SetHeader(hdr)
+	prm.SetPayload(payload)
+
+	return x.p.PutObject(ctx, prm)
+}
+
+func (x poolStorage) DeleteObject(ctx context.Context, addr oid.Address) error {
+	var prm pool.PrmObjectDelete
+	prm.SetAddress(addr)
+
+	return x.p.DeleteObject(ctx, prm)
+}
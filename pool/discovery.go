@@ -0,0 +1,156 @@
+package pool
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"go.uber.org/zap"
+)
+
+// DiscoveryParams groups parameters of the Pool node discovery. When enabled,
+// Pool periodically requests the network map and makes the nodes from it
+// members of the Pool. Discovered nodes which left the network map are removed
+// from the Pool. Nodes specified in InitParameters are never removed.
+type DiscoveryParams struct {
+	interval time.Duration
+	priority int
+	weight   float64
+	filter   func(netmap.NodeInfo) bool
+}
+
+// SetInterval sets the interval of the network map requests. Non-positive
+// value (default) disables discovery.
+func (x *DiscoveryParams) SetInterval(interval time.Duration) {
+	x.interval = interval
+}
+
+// SetPriority sets priority of the discovered nodes (see NodeParam.SetPriority).
+func (x *DiscoveryParams) SetPriority(priority int) {
+	x.priority = priority
+}
+
+// SetWeight sets weight of the discovered nodes (see NodeParam.SetWeight).
+// If not set, 1 is used.
+func (x *DiscoveryParams) SetWeight(weight float64) {
+	x.weight = weight
+}
+
+// SetFilter sets predicate which selects the network map nodes to be added to
+// the Pool. Nil (default) means all nodes.
+func (x *DiscoveryParams) SetFilter(f func(netmap.NodeInfo) bool) {
+	x.filter = f
+}
+
+// discover synchronizes discovered nodes of the Pool with the current network map.
+func (p *Pool) discover(ctx context.Context) {
+	tctx, cancel := context.WithTimeout(ctx, p.rebalanceParams.nodeRequestTimeout)
+	nm, err := p.NetMapSnapshot(tctx)
+	cancel()
+	if err != nil {
+		if p.logger != nil {
+			p.logger.Warn("failed to get network map for node discovery", zap.Error(err))
+		}
+		return
+	}
+
+	endpoints := make(map[string]struct{})
+
+	for _, node := range nm.Nodes() {
+		if p.discovery.filter != nil && !p.discovery.filter(node) {
+			continue
+		}
+
+		if endpoint, ok := nodeEndpoint(node); ok {
+			endpoints[endpoint] = struct{}{}
+		}
+	}
+
+	p.membersLock.RLock()
+	var left []string
+	for addr := range p.discovered {
+		if _, ok := endpoints[addr]; !ok {
+			left = append(left, addr)
+		}
+	}
+	p.membersLock.RUnlock()
+
+	for _, addr := range left {
+		if err = p.RemoveNode(addr); err != nil && p.logger != nil {
+			p.logger.Warn("failed to remove node left the network map", zap.String("address", addr), zap.Error(err))
+		}
+	}
+
+	weight := p.discovery.weight
+	if weight <= 0 {
+		weight = 1
+	}
+
+	for addr := range endpoints {
+		p.membersLock.RLock()
+		exists := p.hasNode(addr)
+		p.membersLock.RUnlock()
+
+		if exists {
+			continue
+		}
+
+		tctx, cancel := context.WithTimeout(ctx, p.rebalanceParams.nodeRequestTimeout)
+		err = p.addNode(tctx, NewNodeParam(p.discovery.priority, addr, weight), true)
+		cancel()
+		if err != nil && p.logger != nil {
+			p.logger.Warn("failed to add discovered node", zap.String("address", addr), zap.Error(err))
+		}
+	}
+}
+
+// nodeEndpoint returns endpoint of the node to connect from outside. External
+// addresses are preferred over the network endpoints.
+func nodeEndpoint(node netmap.NodeInfo) (string, bool) {
+	for _, addr := range node.ExternalAddresses() {
+		if endpoint, ok := endpointFromMultiaddr(addr); ok {
+			return endpoint, true
+		}
+	}
+
+	var res string
+
+	node.IterateNetworkEndpoints(func(addr string) bool {
+		var ok bool
+		res, ok = endpointFromMultiaddr(addr)
+		return ok
+	})
+
+	return res, res != ""
+}
+
+// endpointFromMultiaddr converts multiaddr announced in the network map (e.g.
+// /dns4/s01.neofs.devenv/tcp/8080/tls) to the server URI accepted by the
+// client (see client.PrmDial.SetServerURI). Values which are not multiaddrs
+// are returned as is.
+func endpointFromMultiaddr(addr string) (string, bool) {
+	if !strings.HasPrefix(addr, "/") {
+		return addr, addr != ""
+	}
+
+	parts := strings.Split(addr[1:], "/")
+	if len(parts) < 4 || parts[2] != "tcp" {
+		return "", false
+	}
+
+	switch parts[0] {
+	default:
+		return "", false
+	case "ip4", "ip6", "dns", "dns4", "dns6":
+	}
+
+	res := net.JoinHostPort(parts[1], parts[3])
+
+	if len(parts) > 4 && parts[4] == "tls" {
+		res = "grpcs://" + res
+	}
+
+	return res, true
+}
@@ -0,0 +1,104 @@
+package pool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointFromMultiaddr(t *testing.T) {
+	for _, tc := range []struct {
+		addr     string
+		endpoint string
+		ok       bool
+	}{
+		{addr: "/dns4/s01.neofs.devenv/tcp/8080", endpoint: "s01.neofs.devenv:8080", ok: true},
+		{addr: "/ip4/10.0.0.1/tcp/8080/tls", endpoint: "grpcs://10.0.0.1:8080", ok: true},
+		{addr: "/ip6/::1/tcp/8080", endpoint: "[::1]:8080", ok: true},
+		{addr: "grpc://s01.neofs.devenv:8080", endpoint: "grpc://s01.neofs.devenv:8080", ok: true},
+		{addr: "/ip4/10.0.0.1/udp/8080"},
+		{addr: "/unix/socket"},
+		{addr: ""},
+	} {
+		endpoint, ok := endpointFromMultiaddr(tc.addr)
+		require.Equal(t, tc.ok, ok, tc.addr)
+		if tc.ok {
+			require.Equal(t, tc.endpoint, endpoint, tc.addr)
+		}
+	}
+}
+
+func TestPool_Discovery(t *testing.T) {
+	const attrDiscover = "Discover"
+
+	newNode := func(discover bool, endpoints ...string) netmap.NodeInfo {
+		var node netmap.NodeInfo
+		node.SetNetworkEndpoints(endpoints...)
+		if discover {
+			node.SetAttribute(attrDiscover, "true")
+		}
+		return node
+	}
+
+	peer0 := newMockClient("peer0", *newPrivateKey(t))
+	peer0.setNetMap(
+		newNode(true, "/dns4/peer1/tcp/8080"),
+		newNode(true, "/dns4/peer2/tcp/8080/tls"),
+		newNode(false, "/dns4/peer3/tcp/8080"),
+	)
+
+	var prmDiscovery DiscoveryParams
+	prmDiscovery.SetInterval(20 * time.Millisecond)
+	prmDiscovery.SetPriority(2)
+	prmDiscovery.SetFilter(func(node netmap.NodeInfo) bool {
+		return node.Attribute(attrDiscover) == "true"
+	})
+
+	opts := InitParameters{
+		key: newPrivateKey(t),
+		nodeParams: []NodeParam{
+			{1, "peer0", 1},
+		},
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.SetDiscovery(prmDiscovery)
+	opts.setClientBuilder(func(addr string) client {
+		if addr == "peer0" {
+			return peer0
+		}
+		return newMockClient(addr, *newPrivateKey(t))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	err = pool.Dial(ctx)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	hasNode := func(addr string) bool {
+		_, err := pool.Statistic().Node(addr)
+		return err == nil
+	}
+
+	require.Eventually(t, func() bool {
+		return hasNode("peer1:8080") && hasNode("grpcs://peer2:8080")
+	}, time.Second, 10*time.Millisecond)
+	require.False(t, hasNode("peer3:8080"))
+
+	node, err := pool.Statistic().Node("peer1:8080")
+	require.NoError(t, err)
+	require.Equal(t, 2, node.Priority())
+
+	// static nodes are kept even if they are missing in the network map
+	peer0.setNetMap(newNode(true, "/dns4/peer2/tcp/8080/tls"))
+
+	require.Eventually(t, func() bool { return !hasNode("peer1:8080") }, time.Second, 10*time.Millisecond)
+	require.True(t, hasNode("grpcs://peer2:8080"))
+	require.True(t, hasNode("peer0"))
+}
@@ -0,0 +1,22 @@
+/*
+Package downloader provides parallel download of the large NeoFS objects.
+
+Download splits object payload into ranges, requests them concurrently and
+writes into io.WriterAt. Failed ranges are retried, and downloaded data can
+be verified by object checksum or by Tillich-Zemor hashes of the ranges.
+Interrupted download can be resumed from the partially written data.
+
+Download object into the file through pool.Pool:
+
+	f, err := os.OpenFile("object.bin", os.O_RDWR|os.O_CREATE, 0644)
+	// ...
+
+	var prm downloader.Prm
+	prm.SetParallelism(8)
+	prm.SetVerification(downloader.VerifyChecksum)
+	prm.ResumeFrom(f)
+
+	res, err := downloader.Download(ctx, downloader.NewPoolStorage(p), addr, f, prm)
+	// ...
+*/
+package downloader
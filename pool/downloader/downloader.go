@@ -0,0 +1,427 @@
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+const (
+	// DefaultPartSize is a default size of the downloaded payload ranges.
+	DefaultPartSize = 8 << 20
+
+	// DefaultParallelism is a default number of simultaneously downloaded
+	// ranges.
+	DefaultParallelism = 4
+
+	// DefaultAttempts is a default number of attempts to download each range.
+	DefaultAttempts = 3
+)
+
+// maxHashRanges limits number of ranges per ObjectHash request.
+const maxHashRanges = 256
+
+// ErrChecksumMismatch is returned when downloaded data does not match the
+// checksum declared in NeoFS.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// Verification enumerates ways to verify downloaded payload.
+type Verification uint8
+
+const (
+	// VerifyNone disables verification.
+	VerifyNone Verification = iota
+
+	// VerifyChecksum makes Download to compare SHA256 checksum of the whole
+	// written payload with the PayloadChecksum of the object header. Requires
+	// destination to implement io.ReaderAt.
+	VerifyChecksum
+
+	// VerifyTZ makes Download to compare Tillich-Zemor hash of each downloaded
+	// range with the one calculated by NeoFS (see Storage.ObjectHash).
+	// Ranges failed the check are downloaded again.
+	VerifyTZ
+)
+
+// Prm groups parameters of Download operation.
+type Prm struct {
+	partSize uint64
+
+	parallelism int
+
+	attempts int
+
+	verification Verification
+
+	resume io.ReaderAt
+}
+
+// SetPartSize sets size of the payload ranges. Zero means DefaultPartSize.
+func (x *Prm) SetPartSize(sz uint64) {
+	x.partSize = sz
+}
+
+// SetParallelism sets number of simultaneously downloaded ranges.
+// Non-positive value means DefaultParallelism.
+func (x *Prm) SetParallelism(n int) {
+	x.parallelism = n
+}
+
+// SetAttempts sets number of attempts to download each range.
+// Non-positive value means DefaultAttempts.
+func (x *Prm) SetAttempts(n int) {
+	x.attempts = n
+}
+
+// SetVerification sets the way to verify downloaded payload. Defaults to
+// VerifyNone.
+func (x *Prm) SetVerification(v Verification) {
+	x.verification = v
+}
+
+// ResumeFrom makes Download to skip the ranges which have already been
+// downloaded to the given partial data. Ranges are compared with the object
+// payload by SHA256 checksums calculated by NeoFS (see Storage.ObjectHash),
+// so partial data can be left by any previous attempt with the same part size.
+//
+// Usually, the same file as the destination is passed.
+func (x *Prm) ResumeFrom(r io.ReaderAt) {
+	x.resume = r
+}
+
+// Result groups resulting values of Download operation.
+type Result struct {
+	hdr object.Object
+
+	parts, resumed, retries int
+}
+
+// Header returns header of the downloaded object.
+func (x Result) Header() object.Object {
+	return x.hdr
+}
+
+// Parts returns number of payload ranges.
+func (x Result) Parts() int {
+	return x.parts
+}
+
+// ResumedParts returns number of ranges which were taken from the partial data
+// (see Prm.ResumeFrom).
+func (x Result) ResumedParts() int {
+	return x.resumed
+}
+
+// Retries returns number of repeated range requests.
+func (x Result) Retries() int {
+	return x.retries
+}
+
+// part describes payload range.
+type part struct {
+	off, ln uint64
+
+	// expected TZ hash
+	tz []byte
+}
+
+// Download writes payload of the referenced object into w. Payload is split
+// into ranges which are requested concurrently and retried on failures.
+//
+// If any range is not downloaded after all attempts, the error is returned,
+// and the operation may be resumed later (see Prm.ResumeFrom).
+func Download(ctx context.Context, s Storage, addr oid.Address, w io.WriterAt, prm Prm) (Result, error) {
+	var res Result
+
+	if prm.verification == VerifyChecksum {
+		if _, ok := w.(io.ReaderAt); !ok {
+			return res, errors.New("checksum verification requires destination to implement io.ReaderAt")
+		}
+	}
+
+	var err error
+
+	res.hdr, err = s.HeadObject(ctx, addr)
+	if err != nil {
+		return res, fmt.Errorf("read object header: %w", err)
+	}
+
+	partSize := prm.partSize
+	if partSize == 0 {
+		partSize = DefaultPartSize
+	}
+
+	size := res.hdr.PayloadSize()
+
+	var parts []part
+
+	for off := uint64(0); off < size; off += partSize {
+		ln := partSize
+		if off+ln > size {
+			ln = size - off
+		}
+
+		parts = append(parts, part{off: off, ln: ln})
+	}
+
+	res.parts = len(parts)
+
+	if prm.resume != nil {
+		parts, err = skipResumed(ctx, s, addr, prm.resume, parts)
+		if err != nil {
+			return res, err
+		}
+
+		res.resumed = res.parts - len(parts)
+	}
+
+	if prm.verification == VerifyTZ && len(parts) > 0 {
+		sums, err := hashParts(ctx, s, addr, true, parts)
+		if err != nil {
+			return res, err
+		}
+
+		for i := range parts {
+			parts[i].tz = sums[i]
+		}
+	}
+
+	res.retries, err = downloadParts(ctx, s, addr, w, parts, partSize, prm)
+	if err != nil {
+		return res, err
+	}
+
+	if prm.verification == VerifyChecksum {
+		err = verifyChecksum(w.(io.ReaderAt), res.hdr)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	return res, nil
+}
+
+// hashParts requests checksums of the given payload ranges.
+func hashParts(ctx context.Context, s Storage, addr oid.Address, tz bool, parts []part) ([][]byte, error) {
+	res := make([][]byte, 0, len(parts))
+
+	for len(parts) > 0 {
+		n := len(parts)
+		if n > maxHashRanges {
+			n = maxHashRanges
+		}
+
+		ranges := make([]uint64, 0, 2*n)
+		for i := 0; i < n; i++ {
+			ranges = append(ranges, parts[i].off, parts[i].ln)
+		}
+
+		sums, err := s.ObjectHash(ctx, addr, tz, ranges...)
+		if err != nil {
+			return nil, fmt.Errorf("hash payload ranges: %w", err)
+		}
+
+		if len(sums) != n {
+			return nil, fmt.Errorf("wrong number of range checksums %d, expected %d", len(sums), n)
+		}
+
+		res = append(res, sums...)
+		parts = parts[n:]
+	}
+
+	return res, nil
+}
+
+// skipResumed returns ranges which are missing in the partial data.
+func skipResumed(ctx context.Context, s Storage, addr oid.Address, r io.ReaderAt, parts []part) ([]part, error) {
+	if len(parts) == 0 {
+		return parts, nil
+	}
+
+	sums, err := hashParts(ctx, s, addr, false, parts)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		res []part
+		buf []byte
+	)
+
+	for i := range parts {
+		if uint64(cap(buf)) < parts[i].ln {
+			buf = make([]byte, parts[i].ln)
+		}
+
+		buf = buf[:parts[i].ln]
+
+		n, err := r.ReadAt(buf, int64(parts[i].off))
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("read partial data: %w", err)
+		}
+
+		if n == len(buf) {
+			if sum := sha256.Sum256(buf); bytes.Equal(sum[:], sums[i]) {
+				continue
+			}
+		}
+
+		res = append(res, parts[i])
+	}
+
+	return res, nil
+}
+
+// downloadParts downloads the given ranges concurrently and writes them into w.
+// Returns number of retries.
+func downloadParts(ctx context.Context, s Storage, addr oid.Address, w io.WriterAt, parts []part, partSize uint64, prm Prm) (int, error) {
+	n := prm.parallelism
+	if n <= 0 {
+		n = DefaultParallelism
+	}
+
+	if n > len(parts) {
+		n = len(parts)
+	}
+
+	attempts := prm.attempts
+	if attempts <= 0 {
+		attempts = DefaultAttempts
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mtx      sync.Mutex
+		firstErr error
+		retries  int
+		ch       = make(chan part)
+	)
+
+	fail := func(err error) {
+		mtx.Lock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+		mtx.Unlock()
+	}
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			buf := make([]byte, partSize)
+
+			for p := range ch {
+				data := buf[:p.ln]
+
+				var err error
+
+				for attempt := 0; attempt < attempts; attempt++ {
+					if attempt > 0 {
+						mtx.Lock()
+						retries++
+						mtx.Unlock()
+					}
+
+					err = readPart(ctx, s, addr, p, data)
+					if err == nil || ctx.Err() != nil {
+						break
+					}
+				}
+
+				if err != nil {
+					fail(fmt.Errorf("download range [%d:%d]: %w", p.off, p.off+p.ln, err))
+					continue
+				}
+
+				_, err = w.WriteAt(data, int64(p.off))
+				if err != nil {
+					fail(fmt.Errorf("write range [%d:%d]: %w", p.off, p.off+p.ln, err))
+				}
+			}
+		}()
+	}
+
+loop:
+	for i := range parts {
+		select {
+		case <-ctx.Done():
+			break loop
+		case ch <- parts[i]:
+		}
+	}
+
+	close(ch)
+	wg.Wait()
+
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+
+	return retries, firstErr
+}
+
+// readPart reads payload range into buf and verifies its TZ hash if expected.
+func readPart(ctx context.Context, s Storage, addr oid.Address, p part, buf []byte) error {
+	r, err := s.ObjectRange(ctx, addr, p.off, p.ln)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.ReadFull(r, buf)
+	if closeErr := r.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if p.tz != nil {
+		if sum := tz.Sum(buf); !bytes.Equal(sum[:], p.tz) {
+			return ErrChecksumMismatch
+		}
+	}
+
+	return nil
+}
+
+// verifyChecksum checks SHA256 checksum of the written payload.
+func verifyChecksum(r io.ReaderAt, hdr object.Object) error {
+	cs, ok := hdr.PayloadChecksum()
+	if !ok {
+		return errors.New("missing payload checksum in object header")
+	}
+
+	if cs.Type() != checksum.SHA256 {
+		return fmt.Errorf("unsupported payload checksum type %s", cs.Type())
+	}
+
+	h := sha256.New()
+
+	_, err := io.Copy(h, io.NewSectionReader(r, 0, int64(hdr.PayloadSize())))
+	if err != nil {
+		return fmt.Errorf("read written payload: %w", err)
+	}
+
+	if !bytes.Equal(h.Sum(nil), cs.Value()) {
+		return ErrChecksumMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package downloader_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/pool/downloader"
+	objectstest "github.com/nspcc-dev/neofs-sdk-go/pool/internal/objects/test"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/stretchr/testify/require"
+)
+
+// testStorage stores single object and injects range failures.
+type testStorage struct {
+	objectstest.Storage
+
+	mtx sync.Mutex
+
+	addr    oid.Address
+	payload []byte
+
+	// number of failures for ranges with particular offset
+	failures map[uint64]int
+	// number of corruptions for ranges with particular offset
+	corruptions map[uint64]int
+
+	ranges int
+}
+
+func newTestStorage(t *testing.T, size int) *testStorage {
+	var s testStorage
+
+	s.payload = make([]byte, size)
+	_, err := rand.Read(s.payload)
+	require.NoError(t, err)
+
+	s.addr.SetContainer(cidtest.ID())
+	s.addr.SetObject(s.Add(string(s.payload)))
+
+	return &s
+}
+
+func (x *testStorage) ObjectRange(ctx context.Context, addr oid.Address, off, ln uint64) (io.ReadCloser, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	x.ranges++
+
+	if x.failures[off] > 0 {
+		x.failures[off]--
+		return nil, errors.New("any error")
+	}
+
+	r, err := x.Storage.ObjectRange(ctx, addr, off, ln)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if x.corruptions[off] > 0 {
+		x.corruptions[off]--
+		data[0]++
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (x *testStorage) ObjectHash(_ context.Context, _ oid.Address, useTZ bool, ranges ...uint64) ([][]byte, error) {
+	res := make([][]byte, len(ranges)/2)
+
+	for i := range res {
+		data := x.payload[ranges[2*i] : ranges[2*i]+ranges[2*i+1]]
+
+		if useTZ {
+			sum := tz.Sum(data)
+			res[i] = sum[:]
+		} else {
+			sum := sha256.Sum256(data)
+			res[i] = sum[:]
+		}
+	}
+
+	return res, nil
+}
+
+type writerAt struct {
+	mtx sync.Mutex
+	buf []byte
+}
+
+func (x *writerAt) WriteAt(p []byte, off int64) (int, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	return copy(x.buf[off:], p), nil
+}
+
+func (x *writerAt) ReadAt(p []byte, off int64) (int, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if off >= int64(len(x.buf)) {
+		return 0, io.EOF
+	}
+
+	n := copy(p, x.buf[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
+
+func TestDownload(t *testing.T) {
+	s := newTestStorage(t, 1000)
+	s.failures = map[uint64]int{0: 2, 300: 1}
+	s.corruptions = map[uint64]int{600: 1}
+
+	w := &writerAt{buf: make([]byte, 1000)}
+
+	var prm downloader.Prm
+	prm.SetPartSize(100)
+	prm.SetParallelism(3)
+	prm.SetVerification(downloader.VerifyTZ)
+
+	res, err := downloader.Download(context.Background(), s, s.addr, w, prm)
+	require.NoError(t, err)
+	require.Equal(t, s.payload, w.buf)
+	require.Equal(t, 10, res.Parts())
+	require.Zero(t, res.ResumedParts())
+	require.Equal(t, 4, res.Retries())
+	require.Equal(t, 14, s.ranges)
+
+	t.Run("checksum", func(t *testing.T) {
+		s := newTestStorage(t, 1000)
+		w := &writerAt{buf: make([]byte, 1000)}
+
+		prm.SetVerification(downloader.VerifyChecksum)
+
+		_, err := downloader.Download(context.Background(), s, s.addr, w, prm)
+		require.NoError(t, err)
+		require.Equal(t, s.payload, w.buf)
+
+		// corruption is detected in the end only
+		s.corruptions = map[uint64]int{500: 1}
+
+		_, err = downloader.Download(context.Background(), s, s.addr, w, prm)
+		require.ErrorIs(t, err, downloader.ErrChecksumMismatch)
+
+		_, err = downloader.Download(context.Background(), s, s.addr, struct{ io.WriterAt }{w}, prm)
+		require.Error(t, err)
+	})
+
+	t.Run("failure and resume", func(t *testing.T) {
+		s := newTestStorage(t, 950)
+		s.failures = map[uint64]int{500: 3}
+
+		w := &writerAt{buf: make([]byte, 950)}
+
+		var prm downloader.Prm
+		prm.SetPartSize(100)
+		prm.SetParallelism(1)
+		prm.SetAttempts(3)
+
+		_, err := downloader.Download(context.Background(), s, s.addr, w, prm)
+		require.Error(t, err)
+		require.Equal(t, s.payload[:500], w.buf[:500])
+
+		s.ranges = 0
+		prm.ResumeFrom(w)
+
+		res, err := downloader.Download(context.Background(), s, s.addr, w, prm)
+		require.NoError(t, err)
+		require.Equal(t, s.payload, w.buf)
+		require.Equal(t, 10, res.Parts())
+		require.Equal(t, 5, res.ResumedParts())
+		require.Equal(t, 5, s.ranges)
+	})
+}
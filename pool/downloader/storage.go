@@ -0,0 +1,53 @@
+package downloader
+
+import (
+	"context"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/pool"
+	"github.com/nspcc-dev/neofs-sdk-go/pool/internal/objects"
+)
+
+// Storage is an interface of NeoFS object storage used by Download.
+type Storage interface {
+	// HeadObject returns header of the referenced object.
+	HeadObject(ctx context.Context, addr oid.Address) (object.Object, error)
+
+	// ObjectRange opens the payload range of the referenced object.
+	ObjectRange(ctx context.Context, addr oid.Address, off, ln uint64) (io.ReadCloser, error)
+
+	// ObjectHash returns checksums of the payload ranges in (offset, length)
+	// pair format. Tillich-Zemor hash is used if tz is set, SHA256 otherwise.
+	ObjectHash(ctx context.Context, addr oid.Address, tz bool, ranges ...uint64) ([][]byte, error)
+}
+
+// NewPoolStorage returns Storage working through the given pool.Pool.
+// Requests are distributed over the Pool nodes, so parallel ranges are
+// fetched through different connections.
+func NewPoolStorage(p *pool.Pool) Storage {
+	return poolStorage{
+		Pool: objects.NewPool(p),
+		p:    p,
+	}
+}
+
+// poolStorage implements Storage through pool.Pool.
+type poolStorage struct {
+	objects.Pool
+
+	p *pool.Pool
+}
+
+func (x poolStorage) ObjectHash(ctx context.Context, addr oid.Address, tz bool, ranges ...uint64) ([][]byte, error) {
+	var prm pool.PrmObjectHash
+	prm.SetAddress(addr)
+	prm.SetRangeList(ranges...)
+
+	if tz {
+		prm.TillichZemorAlgo()
+	}
+
+	return x.p.ObjectHash(ctx, prm)
+}
@@ -0,0 +1,228 @@
+package pool
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// defaultHedgingMaxRatio is a default limit of the hedged reads to all reads ratio.
+const defaultHedgingMaxRatio = 0.1
+
+// HedgingParams groups parameters of the hedged reads. When enabled, HeadObject,
+// GetObject and ObjectRange which haven't received the first response within
+// the threshold are repeated on another node. The first successful response
+// is returned while the other request is canceled. Canceled requests are not
+// counted as node errors.
+//
+// Hedging is enabled if either delay or latency multiplier is set.
+type HedgingParams struct {
+	delay             time.Duration
+	latencyMultiplier float64
+	maxRatio          float64
+}
+
+// SetDelay sets static threshold of the first response after which the read
+// is hedged. The delay is also used when the threshold can't be derived from
+// the node latency (see SetLatencyMultiplier).
+func (x *HedgingParams) SetDelay(delay time.Duration) {
+	x.delay = delay
+}
+
+// SetLatencyMultiplier makes the threshold of the first response to be derived
+// from the latency of the node processing the read: the threshold is the
+// average latency of the node multiplied by m.
+//
+// See also NodeStatistic.Latency.
+func (x *HedgingParams) SetLatencyMultiplier(m float64) {
+	x.latencyMultiplier = m
+}
+
+// SetMaxRatio limits extra load produced by hedging: number of the hedged reads
+// never exceeds the given fraction of all reads. If not set, 0.1 is used.
+func (x *HedgingParams) SetMaxRatio(ratio float64) {
+	x.maxRatio = ratio
+}
+
+// enabled checks if hedging is configured.
+func (x HedgingParams) enabled() bool {
+	return x.delay > 0 || x.latencyMultiplier > 0
+}
+
+// threshold returns duration of the read from the given node after which the
+// read is hedged. Non-positive value means no hedging.
+func (x HedgingParams) threshold(cp client) time.Duration {
+	if x.latencyMultiplier > 0 {
+		if l := cp.latency(); l > 0 {
+			return time.Duration(x.latencyMultiplier * float64(l))
+		}
+	}
+
+	return x.delay
+}
+
+// allowHedge checks if one more read can be hedged without exceeding the
+// configured ratio and counts the hedged read if so.
+func (p *Pool) allowHedge() bool {
+	ratio := p.hedging.maxRatio
+	if ratio <= 0 {
+		ratio = defaultHedgingMaxRatio
+	}
+
+	for {
+		hedges := p.hedges.Load()
+		if float64(hedges) >= ratio*float64(p.hedgeableReads.Load()) {
+			return false
+		}
+
+		if p.hedges.CAS(hedges, hedges+1) {
+			return true
+		}
+	}
+}
+
+// hedgedRead performs read on the node selected by the pool and, if the read
+// does not complete within the hedging threshold, performs the same read on
+// another node. The first successful read wins: contexts of the other reads
+// are canceled and release is called for them if they succeed anyway.
+//
+// method is used to select the nodes. read is called with the read number (0
+// for the original read, 1 for the hedged one) and must store the result on its
+// own. hedgedRead returns the number of the winner and the function which must
+// be called to release the context of the winner when its result is no longer
+// used.
+func (p *Pool) hedgedRead(ctx context.Context, method MethodIndex, read func(ctx context.Context, i int, cp client) error, release func(i int)) (int, context.CancelFunc, error) {
+	first, err := p.connectionFor(method)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	p.hedgeableReads.Inc()
+
+	type result struct {
+		i   int
+		err error
+	}
+
+	var (
+		results = make(chan result, 2)
+		cancels = make([]context.CancelFunc, 0, 2)
+		running int
+	)
+
+	start := func(cp client) {
+		i := len(cancels)
+		readCtx, cancel := context.WithCancel(ctx)
+		cancels = append(cancels, cancel)
+		running++
+
+		go func() {
+			results <- result{i: i, err: read(readCtx, i, cp)}
+		}()
+	}
+
+	start(first)
+
+	// nil channel blocks forever, so the read is not hedged without a threshold
+	var hedgeCh <-chan time.Time
+	if threshold := p.hedging.threshold(first); threshold > 0 {
+		timer := time.NewTimer(threshold)
+		defer timer.Stop()
+		hedgeCh = timer.C
+	}
+
+	var firstErr error
+
+	for {
+		select {
+		case <-hedgeCh:
+			hedgeCh = nil
+
+			if second, ok := p.hedgeConnection(first, method); ok && p.allowHedge() {
+				start(second)
+			}
+		case res := <-results:
+			running--
+
+			if res.err != nil {
+				cancels[res.i]()
+
+				if firstErr == nil {
+					firstErr = res.err
+				}
+
+				if running == 0 {
+					return 0, nil, firstErr
+				}
+
+				continue
+			}
+
+			for i := range cancels {
+				if i != res.i {
+					cancels[i]()
+				}
+			}
+
+			if running > 0 {
+				go func(running int) {
+					for ; running > 0; running-- {
+						if res := <-results; res.err == nil {
+							release(res.i)
+						}
+					}
+				}(running)
+			}
+
+			if res.i > 0 {
+				p.hedgeWins.Inc()
+			}
+
+			return res.i, cancels[res.i], nil
+		}
+	}
+}
+
+// hedgeConnection returns healthy connection other than the given one which
+// can serve the request of the given method. Nodes of the lower priority
+// groups are used if there are no other healthy nodes in the group of the
+// given one.
+func (p *Pool) hedgeConnection(exclude client, method MethodIndex) (client, bool) {
+	p.membersLock.RLock()
+	innerPools := p.innerPools
+	p.membersLock.RUnlock()
+
+	for _, inner := range innerPools {
+		if cp, ok := inner.connectionExcept(exclude, method); ok {
+			return cp, true
+		}
+	}
+
+	return nil, false
+}
+
+func (p *innerPool) connectionExcept(exclude client, method MethodIndex) (client, bool) {
+	p.lock.RLock() // need lock because of using p.sampler
+	defer p.lock.RUnlock()
+
+	attempts := 3 * len(p.clients)
+	for k := 0; k < attempts; k++ {
+		if cp := p.clients[p.sampler.Next()]; cp != exclude && isAvailable(cp) && cp.allowRequest(method) {
+			return cp, true
+		}
+	}
+
+	return nil, false
+}
+
+// hedgedReadCloser releases the context of the hedged read on close.
+type hedgedReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close implements io.Closer.
+func (x *hedgedReadCloser) Close() error {
+	defer x.cancel()
+	return x.ReadCloser.Close()
+}
@@ -0,0 +1,168 @@
+package pool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHedgedRead(t *testing.T) {
+	slow := newMockClient("slow", *newPrivateKey(t))
+	slow.headDelay = time.Minute
+
+	fast := newMockClient("fast", *newPrivateKey(t))
+
+	var prmHedging HedgingParams
+	prmHedging.SetDelay(10 * time.Millisecond)
+	prmHedging.SetMaxRatio(0.5)
+
+	opts := InitParameters{
+		key: newPrivateKey(t),
+		nodeParams: []NodeParam{
+			{1, "slow", 1},
+			{2, "fast", 1},
+		},
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.SetHedging(prmHedging)
+	opts.setClientBuilder(func(addr string) client {
+		if addr == "slow" {
+			return slow
+		}
+		return fast
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	err = pool.Dial(ctx)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	var prm PrmObjectHead
+	prm.SetAddress(oid.Address{})
+
+	obj, err := pool.HeadObject(ctx, prm)
+	require.NoError(t, err)
+	require.Equal(t, []byte("fast"), obj.Payload())
+
+	// loser is canceled
+	require.Eventually(t, slow.headCanceled.Load, time.Second, 10*time.Millisecond)
+
+	stat := pool.Statistic()
+	require.EqualValues(t, 1, stat.Hedges())
+	require.EqualValues(t, 1, stat.HedgeWins())
+
+	t.Run("load cap", func(t *testing.T) {
+		headCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		// hedged reads don't exceed half of all reads: 1 of 2
+		_, err = pool.HeadObject(headCtx, prm)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.EqualValues(t, 1, pool.Statistic().Hedges())
+
+		// 2 of 3
+		_, err = pool.HeadObject(ctx, prm)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, pool.Statistic().Hedges())
+	})
+
+	t.Run("fast original", func(t *testing.T) {
+		slow.headDelay = 0
+		defer func() { slow.headDelay = time.Minute }()
+
+		obj, err := pool.HeadObject(ctx, prm)
+		require.NoError(t, err)
+		require.Equal(t, []byte("slow"), obj.Payload())
+		require.EqualValues(t, 2, pool.Statistic().Hedges())
+	})
+}
+
+func TestHedgedRead_LoserHealth(t *testing.T) {
+	const reads = 20
+
+	slow := newMockClient("slow", *newPrivateKey(t))
+	slow.headDelay = time.Minute
+
+	fast := newMockClient("fast", *newPrivateKey(t))
+
+	var prmHedging HedgingParams
+	prmHedging.SetDelay(time.Millisecond)
+	prmHedging.SetMaxRatio(1)
+
+	var prmBreaker CircuitBreakerParams
+	prmBreaker.SetFailureRatio(0.5)
+	prmBreaker.SetMinRequests(5)
+	prmBreaker.SetWindow(time.Minute)
+	prmBreaker.SetCooldown(time.Minute)
+
+	opts := InitParameters{
+		key: newPrivateKey(t),
+		nodeParams: []NodeParam{
+			{1, "slow", 1},
+			{2, "fast", 1},
+		},
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.SetHedging(prmHedging)
+	opts.SetCircuitBreaker(prmBreaker)
+	opts.setClientBuilder(func(addr string) client {
+		if addr == "slow" {
+			return slow
+		}
+		return fast
+	})
+
+	ctx := context.Background()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, pool.Dial(ctx))
+	t.Cleanup(pool.Close)
+
+	var prm PrmObjectHead
+	prm.SetAddress(oid.Address{})
+
+	for i := 0; i < reads; i++ {
+		slow.headCanceled.Store(false)
+
+		obj, err := pool.HeadObject(ctx, prm)
+		require.NoError(t, err)
+		require.Equal(t, []byte("fast"), obj.Payload())
+
+		require.Eventually(t, slow.headCanceled.Load, time.Second, time.Millisecond)
+	}
+
+	require.EqualValues(t, reads, pool.Statistic().HedgeWins())
+
+	// canceled losers are not node failures
+	node, err := pool.Statistic().Node("slow")
+	require.NoError(t, err)
+	require.Zero(t, node.OverallErrors())
+	require.Equal(t, CircuitClosed, node.CircuitState())
+	require.True(t, slow.isHealthy())
+}
+
+func TestHedgingParams_Threshold(t *testing.T) {
+	cli := newMockClient("", *newPrivateKey(t))
+
+	var prm HedgingParams
+	require.False(t, prm.enabled())
+
+	prm.SetDelay(time.Second)
+	require.True(t, prm.enabled())
+	require.Equal(t, time.Second, prm.threshold(cli))
+
+	prm.SetLatencyMultiplier(3)
+	// no latency yet
+	require.Equal(t, time.Second, prm.threshold(cli))
+
+	cli.observeLatency(10 * time.Millisecond)
+	require.Equal(t, 30*time.Millisecond, prm.threshold(cli))
+}
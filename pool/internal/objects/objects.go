@@ -0,0 +1,166 @@
+/*
+Package objects provides common object operations of the pool subpackages
+working with the container objects as with files.
+*/
+package objects
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/pool"
+)
+
+// Attribute returns value of the object attribute with the given key.
+// Returns empty string if attribute is missing.
+func Attribute(hdr object.Object, key string) string {
+	for _, a := range hdr.Attributes() {
+		if a.Key() == key {
+			return a.Value()
+		}
+	}
+
+	return ""
+}
+
+// FilePath returns value of the FilePath attribute of the object relative to
+// the container root, i.e. without the leading slash.
+func FilePath(hdr object.Object) string {
+	return strings.TrimPrefix(Attribute(hdr, object.AttributeFilePath), "/")
+}
+
+// SearchPath selects the container objects with FilePath attribute matching
+// the given path relative to the container root through the given search
+// function. Paths are matched both with and without the leading slash.
+func SearchPath(ctx context.Context, search func(context.Context, cid.ID, object.SearchFilters) ([]oid.ID, error),
+	cnrID cid.ID, p string, m object.SearchMatchType) ([]oid.ID, error) {
+	var res []oid.ID
+
+	found := make(map[oid.ID]struct{})
+
+	for _, v := range [...]string{"/" + p, p} {
+		var filters object.SearchFilters
+		filters.AddFilter(object.AttributeFilePath, v, m)
+
+		ids, err := search(ctx, cnrID, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range ids {
+			if _, ok := found[ids[i]]; !ok {
+				found[ids[i]] = struct{}{}
+				res = append(res, ids[i])
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// Timestamp returns time from the Timestamp attribute of the object. Returns
+// zero time if attribute is missing or invalid.
+func Timestamp(hdr object.Object) time.Time {
+	if ts := Attribute(hdr, object.AttributeTimestamp); ts != "" {
+		if sec, err := strconv.ParseInt(ts, 10, 64); err == nil {
+			return time.Unix(sec, 0)
+		}
+	}
+
+	return time.Time{}
+}
+
+// Newer checks if object a modified at aTime is newer than object b modified
+// at bTime. Objects with the same modification time are ordered by
+// identifiers to be deterministic.
+func Newer(a oid.ID, aTime time.Time, b oid.ID, bTime time.Time) bool {
+	if !aTime.Equal(bTime) {
+		return aTime.After(bTime)
+	}
+
+	return a.EncodeToString() > b.EncodeToString()
+}
+
+// Pool provides object reading operations through pool.Pool in the form
+// used by the storage interfaces of the pool subpackages.
+type Pool struct {
+	p *pool.Pool
+}
+
+// NewPool returns Pool working through the given pool.Pool.
+func NewPool(p *pool.Pool) Pool {
+	return Pool{p: p}
+}
+
+// SearchObjects returns identifiers of the container objects matching
+// the given filters.
+func (x Pool) SearchObjects(ctx context.Context, cnrID cid.ID, filters object.SearchFilters) ([]oid.ID, error) {
+	var prm pool.PrmObjectSearch
+	prm.SetContainerID(cnrID)
+	prm.SetFilters(filters)
+
+	res, err := x.p.SearchObjects(ctx, prm)
+	if err != nil {
+		return nil, fmt.Errorf("init object search: %w", err)
+	}
+
+	var ids []oid.ID
+
+	err = res.Iterate(func(id oid.ID) bool {
+		ids = append(ids, id)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iterate found objects: %w", err)
+	}
+
+	return ids, nil
+}
+
+// HeadObject returns header of the referenced object.
+func (x Pool) HeadObject(ctx context.Context, addr oid.Address) (object.Object, error) {
+	var prm pool.PrmObjectHead
+	prm.SetAddress(addr)
+
+	return x.p.HeadObject(ctx, prm)
+}
+
+// GetObject opens payload of the referenced object.
+func (x Pool) GetObject(ctx context.Context, addr oid.Address) (io.ReadCloser, error) {
+	var prm pool.PrmObjectGet
+	prm.SetAddress(addr)
+
+	res, err := x.p.GetObject(ctx, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Payload, nil
+}
+
+// ObjectRange opens payload range of the referenced object. Zero offset and
+// length mean the whole payload.
+func (x Pool) ObjectRange(ctx context.Context, addr oid.Address, off, ln uint64) (io.ReadCloser, error) {
+	if off == 0 && ln == 0 {
+		return x.GetObject(ctx, addr)
+	}
+
+	var prm pool.PrmObjectRange
+	prm.SetAddress(addr)
+	prm.SetOffset(off)
+	prm.SetLength(ln)
+
+	res, err := x.p.ObjectRange(ctx, prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,15 @@
+/*
+Package objectstest provides in-memory object storage for convenient testing
+of the pool subpackages working with the container objects as with files.
+
+Note that importing the package into source files is highly discouraged.
+
+Storage implements storage interfaces of the subpackages, e.g.:
+
+	import objectstest "github.com/nspcc-dev/neofs-sdk-go/pool/internal/objects/test"
+
+	var s objectstest.Storage
+	s.Add("Hello, world!", object.AttributeFilePath, "/hello.txt")
+	// test the storage
+*/
+package objectstest
@@ -0,0 +1,219 @@
+package objectstest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+)
+
+// Storage stores objects in memory. Containers are ignored, objects are
+// referenced by identifiers only. Search supports MatchStringEqual,
+// MatchCommonPrefix and MatchNotPresent attribute filters.
+//
+// Zero Storage is empty and ready to use. Storage is safe for concurrent use.
+type Storage struct {
+	mtx sync.Mutex
+
+	objs map[oid.ID]object.Object
+
+	puts, deletes int
+}
+
+// Add stores object with the given payload and attributes in (key, value)
+// pair format and returns its identifier.
+func (x *Storage) Add(payload string, attrs ...string) oid.ID {
+	var obj object.Object
+
+	as := make([]object.Attribute, len(attrs)/2)
+	for i := range as {
+		as[i].SetKey(attrs[2*i])
+		as[i].SetValue(attrs[2*i+1])
+	}
+
+	obj.SetAttributes(as...)
+	setPayload(&obj, []byte(payload))
+
+	id := oidtest.ID()
+
+	x.mtx.Lock()
+	x.store(id, obj)
+	x.mtx.Unlock()
+
+	return id
+}
+
+// Object returns the referenced object.
+func (x *Storage) Object(id oid.ID) (object.Object, bool) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	obj, ok := x.objs[id]
+
+	return obj, ok
+}
+
+// Objects returns all stored objects.
+func (x *Storage) Objects() []object.Object {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	res := make([]object.Object, 0, len(x.objs))
+	for _, obj := range x.objs {
+		res = append(res, obj)
+	}
+
+	return res
+}
+
+// Puts returns number of objects stored by PutObject.
+func (x *Storage) Puts() int {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	return x.puts
+}
+
+// Deletes returns number of DeleteObject calls.
+func (x *Storage) Deletes() int {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	return x.deletes
+}
+
+func (x *Storage) store(id oid.ID, obj object.Object) {
+	if x.objs == nil {
+		x.objs = make(map[oid.ID]object.Object)
+	}
+
+	x.objs[id] = obj
+}
+
+func setPayload(obj *object.Object, payload []byte) {
+	var cs checksum.Checksum
+	cs.SetSHA256(sha256.Sum256(payload))
+
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	obj.SetPayloadChecksum(cs)
+}
+
+// SearchObjects returns identifiers of the objects matching the given
+// filters.
+func (x *Storage) SearchObjects(_ context.Context, _ cid.ID, filters object.SearchFilters) ([]oid.ID, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	var res []oid.ID
+
+loop:
+	for id, obj := range x.objs {
+		for _, f := range filters {
+			var (
+				val     string
+				present bool
+			)
+
+			for _, a := range obj.Attributes() {
+				if a.Key() == f.Header() {
+					val, present = a.Value(), true
+					break
+				}
+			}
+
+			switch f.Operation() {
+			case object.MatchStringEqual:
+				if !present || val != f.Value() {
+					continue loop
+				}
+			case object.MatchCommonPrefix:
+				if !present || !strings.HasPrefix(val, f.Value()) {
+					continue loop
+				}
+			case object.MatchNotPresent:
+				if present {
+					continue loop
+				}
+			default:
+				panic("unsupported operation")
+			}
+		}
+
+		res = append(res, id)
+	}
+
+	return res, nil
+}
+
+// HeadObject returns header of the referenced object.
+func (x *Storage) HeadObject(_ context.Context, addr oid.Address) (object.Object, error) {
+	obj, ok := x.Object(addr.Object())
+	if !ok {
+		return obj, apistatus.ObjectNotFound{}
+	}
+
+	return obj, nil
+}
+
+// GetObject opens payload of the referenced object.
+func (x *Storage) GetObject(ctx context.Context, addr oid.Address) (io.ReadCloser, error) {
+	return x.ObjectRange(ctx, addr, 0, 0)
+}
+
+// ObjectRange opens payload range of the referenced object. Zero length
+// means the rest of payload from the offset.
+func (x *Storage) ObjectRange(_ context.Context, addr oid.Address, off, ln uint64) (io.ReadCloser, error) {
+	obj, ok := x.Object(addr.Object())
+	if !ok {
+		return nil, apistatus.ObjectNotFound{}
+	}
+
+	payload := obj.Payload()[off:]
+	if ln > 0 {
+		payload = payload[:ln]
+	}
+
+	return io.NopCloser(bytes.NewReader(payload)), nil
+}
+
+// PutObject stores object with the given header and payload. Payload size
+// and SHA256 checksum are set by the Storage.
+func (x *Storage) PutObject(_ context.Context, hdr object.Object, payload io.Reader) (oid.ID, error) {
+	data, err := io.ReadAll(payload)
+	if err != nil {
+		return oid.ID{}, err
+	}
+
+	setPayload(&hdr, data)
+
+	id := oidtest.ID()
+
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	x.store(id, hdr)
+	x.puts++
+
+	return id, nil
+}
+
+// DeleteObject removes the referenced object.
+func (x *Storage) DeleteObject(_ context.Context, addr oid.Address) error {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	delete(x.objs, addr.Object())
+	x.deletes++
+
+	return nil
+}
@@ -0,0 +1,325 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	sdkClient "github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+// PrmObjectLock groups parameters of LockObjects operation.
+type PrmObjectLock struct {
+	prmCommon
+	sdkClient.LockExpiration
+
+	cnrID cid.ID
+
+	members []oid.ID
+}
+
+// SetContainerID specifies the container of the locked objects.
+func (x *PrmObjectLock) SetContainerID(cnrID cid.ID) {
+	x.cnrID = cnrID
+}
+
+// SetMembers specifies identifiers of the objects to be locked. All objects
+// MUST be regular and belong to the container set using SetContainerID.
+func (x *PrmObjectLock) SetMembers(ids ...oid.ID) {
+	x.members = ids
+}
+
+// ResObjectLock groups resulting values of LockObjects and ExtendObjectLock
+// operations.
+type ResObjectLock struct {
+	id oid.ID
+
+	exp uint64
+}
+
+// LockID returns identifier of the stored LOCK object.
+func (x ResObjectLock) LockID() oid.ID {
+	return x.id
+}
+
+// ExpirationEpoch returns the last epoch of the lock.
+func (x ResObjectLock) ExpirationEpoch() uint64 {
+	return x.exp
+}
+
+// LockObjects locks the objects from removal until the expiration set in
+// parameters. LOCK object is stored through PutObject, so it's finalized by
+// the storage node within the default session unless session is specified.
+//
+// Locking of non-regular objects results in apistatus.LockNonRegularObject
+// error (see client.IsErrLockNonRegularObject).
+//
+// Main return value MUST NOT be processed on an erroneous return.
+func (p *Pool) LockObjects(ctx context.Context, prm PrmObjectLock) (ResObjectLock, error) {
+	switch {
+	case len(prm.members) == 0:
+		return ResObjectLock{}, errors.New("missing objects to lock")
+	}
+
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return ResObjectLock{}, err
+	}
+	defer done()
+
+	exp, err := p.lockExpirationEpoch(ctx, prm.LockExpiration)
+	if err != nil {
+		return ResObjectLock{}, err
+	}
+
+	id, err := p.putLock(ctx, prm.prmCommon, prm.cnrID, prm.members, exp)
+	if err != nil {
+		return ResObjectLock{}, err
+	}
+
+	return ResObjectLock{
+		id:  id,
+		exp: exp,
+	}, nil
+}
+
+// PrmObjectExtendLock groups parameters of ExtendObjectLock operation.
+type PrmObjectExtendLock struct {
+	prmCommon
+	sdkClient.LockExpiration
+
+	addr oid.Address
+}
+
+// SetAddress specifies NeoFS address of the LOCK object to be extended.
+func (x *PrmObjectExtendLock) SetAddress(addr oid.Address) {
+	x.addr = addr
+}
+
+// ExtendObjectLock extends the lock with the given address. Since LOCK objects
+// are immutable, the call reads members of the referenced lock and stores new
+// LOCK object with the same members and later expiration. The original lock
+// remains valid until its own expiration.
+//
+// Returns an error if new expiration epoch is not after the current one of
+// the referenced lock.
+//
+// Main return value MUST NOT be processed on an erroneous return.
+func (p *Pool) ExtendObjectLock(ctx context.Context, prm PrmObjectExtendLock) (ResObjectLock, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return ResObjectLock{}, err
+	}
+	defer done()
+
+	info, err := p.readLock(ctx, prm.prmCommon, prm.addr)
+	if err != nil {
+		return ResObjectLock{}, fmt.Errorf("read lock %s: %w", prm.addr, err)
+	}
+
+	exp, err := p.lockExpirationEpoch(ctx, prm.LockExpiration)
+	if err != nil {
+		return ResObjectLock{}, err
+	}
+
+	if exp <= info.ExpirationEpoch() {
+		return ResObjectLock{}, fmt.Errorf("new expiration epoch %d is not after the current one %d", exp, info.ExpirationEpoch())
+	}
+
+	id, err := p.putLock(ctx, prm.prmCommon, prm.addr.Container(), info.Members(), exp)
+	if err != nil {
+		return ResObjectLock{}, err
+	}
+
+	return ResObjectLock{
+		id:  id,
+		exp: exp,
+	}, nil
+}
+
+// PrmObjectListLocks groups parameters of ListObjectLocks operation.
+type PrmObjectListLocks struct {
+	prmCommon
+
+	addr oid.Address
+}
+
+// SetAddress specifies NeoFS address of the object to list locks for.
+func (x *PrmObjectListLocks) SetAddress(addr oid.Address) {
+	x.addr = addr
+}
+
+// ListObjectLocks lists all locks of the object which have not expired yet.
+// The call searches for all LOCK objects in the container and reads each of
+// them, so it is heavy for the containers with lots of locks.
+//
+// Main return value MUST NOT be processed on an erroneous return.
+func (p *Pool) ListObjectLocks(ctx context.Context, prm PrmObjectListLocks) ([]sdkClient.ObjectLockInfo, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	ni, err := p.networkInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("network info: %w", err)
+	}
+
+	var filters object.SearchFilters
+	filters.AddTypeFilter(object.MatchStringEqual, object.TypeLock)
+
+	var prmSearch PrmObjectSearch
+	prmSearch.prmCommon = prm.prmCommon
+	prmSearch.SetContainerID(prm.addr.Container())
+	prmSearch.SetFilters(filters)
+
+	resSearch, err := p.searchObjects(ctx, prmSearch)
+	if err != nil {
+		return nil, fmt.Errorf("init LOCK objects search: %w", err)
+	}
+
+	var ids []oid.ID
+
+	err = resSearch.Iterate(func(id oid.ID) bool {
+		ids = append(ids, id)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search LOCK objects: %w", err)
+	}
+
+	var res []sdkClient.ObjectLockInfo
+	var addr oid.Address
+	addr.SetContainer(prm.addr.Container())
+
+	for i := range ids {
+		addr.SetObject(ids[i])
+
+		info, err := p.readLock(ctx, prm.prmCommon, addr)
+		if err != nil {
+			if sdkClient.IsErrObjectNotFound(err) || sdkClient.IsErrObjectAlreadyRemoved(err) {
+				// lock has been removed after the search
+				continue
+			}
+
+			return nil, fmt.Errorf("read lock %s: %w", ids[i], err)
+		}
+
+		if info.ExpirationEpoch() != 0 && info.ExpirationEpoch() < ni.CurrentEpoch() {
+			continue
+		}
+
+		members := info.Members()
+
+		for j := range members {
+			if members[j].Equals(prm.addr.Object()) {
+				res = append(res, info)
+				break
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// lockExpirationEpoch returns the last epoch of the lock with the given
+// expiration. Network info is requested for time-based expirations only.
+func (p *Pool) lockExpirationEpoch(ctx context.Context, exp sdkClient.LockExpiration) (uint64, error) {
+	return exp.Epoch(func() (netmap.NetworkInfo, error) {
+		ni, err := p.networkInfo(ctx)
+		if err != nil {
+			return netmap.NetworkInfo{}, fmt.Errorf("network info: %w", err)
+		}
+
+		return ni, nil
+	})
+}
+
+// putLock forms LOCK object with the given members and expiration epoch and
+// stores it through PutObject. Returns identifier of the stored object.
+func (p *Pool) putLock(ctx context.Context, prm prmCommon, cnrID cid.ID, members []oid.ID, exp uint64) (oid.ID, error) {
+	var lock object.Lock
+	lock.WriteMembers(members)
+
+	id, err := p.putSystemObject(ctx, prm, cnrID, object.TypeLock, lock.Marshal(), exp)
+	if err != nil {
+		return oid.ID{}, fmt.Errorf("write LOCK object: %w", err)
+	}
+
+	return id, nil
+}
+
+// putSystemObject forms object of the given type with the given payload and
+// expiration epoch and stores it through PutObject. Returns identifier of the
+// stored object.
+func (p *Pool) putSystemObject(ctx context.Context, prm prmCommon, cnrID cid.ID, typ object.Type, payload []byte, exp uint64) (oid.ID, error) {
+	p.fillAppropriateKey(&prm)
+
+	var owner user.ID
+	user.IDFromKey(&owner, prm.key.PublicKey)
+
+	var hdr object.Object
+	hdr.SetContainerID(cnrID)
+	hdr.SetOwnerID(&owner)
+
+	ver := version.Current()
+	hdr.SetVersion(&ver)
+	hdr.SetType(typ)
+	hdr.SetPayload(payload)
+	hdr.SetPayloadSize(uint64(len(payload)))
+
+	var a object.Attribute
+	a.SetKey(object.AttributeExpirationEpoch)
+	a.SetValue(strconv.FormatUint(exp, 10))
+
+	hdr.SetAttributes(a)
+
+	var prmPut PrmObjectPut
+	prmPut.prmCommon = prm
+	prmPut.SetHeader(hdr)
+
+	return p.putObject(ctx, prmPut)
+}
+
+// readLock reads LOCK object by its address and decodes its payload.
+func (p *Pool) readLock(ctx context.Context, prm prmCommon, addr oid.Address) (sdkClient.ObjectLockInfo, error) {
+	var prmGet PrmObjectGet
+	prmGet.prmCommon = prm
+	prmGet.SetAddress(addr)
+
+	res, err := p.getObject(ctx, prmGet)
+	if err != nil {
+		return sdkClient.ObjectLockInfo{}, err
+	}
+
+	if res.Payload == nil {
+		res.Payload = io.NopCloser(bytes.NewReader(nil))
+	}
+
+	payload, err := io.ReadAll(res.Payload)
+	_ = res.Payload.Close()
+	if err != nil {
+		return sdkClient.ObjectLockInfo{}, fmt.Errorf("read payload: %w", err)
+	}
+
+	res.Header.SetPayload(payload)
+
+	var info sdkClient.ObjectLockInfo
+
+	err = sdkClient.ReadLockInfo(&info, addr.Object(), res.Header)
+	if err != nil {
+		return sdkClient.ObjectLockInfo{}, err
+	}
+
+	return info, nil
+}
@@ -0,0 +1,202 @@
+package pool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdkClient "github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool_LockObjects(t *testing.T) {
+	mockCli := newMockClient("peer0", *newPrivateKey(t))
+
+	opts := InitParameters{
+		key:        newPrivateKey(t),
+		nodeParams: []NodeParam{{1, "peer0", 1}},
+	}
+	opts.setClientBuilder(func(string) client {
+		return mockCli
+	})
+
+	p, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, p.Dial(context.Background()))
+	t.Cleanup(p.Close)
+
+	cnr := cidtest.ID()
+	members := []oid.ID{oidtest.ID(), oidtest.ID()}
+
+	var prm PrmObjectLock
+	prm.SetContainerID(cnr)
+
+	_, err = p.LockObjects(context.Background(), prm)
+	require.Error(t, err)
+
+	prm.SetMembers(members...)
+
+	_, err = p.LockObjects(context.Background(), prm)
+	require.Error(t, err)
+
+	// mocked network info has no epoch duration
+	prm.SetExpirationTime(time.Now().Add(time.Hour))
+
+	_, err = p.LockObjects(context.Background(), prm)
+	require.Error(t, err)
+
+	prm.SetExpirationEpoch(100)
+
+	res, err := p.LockObjects(context.Background(), prm)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, res.ExpirationEpoch())
+	require.Len(t, mockCli.putHeaders, 1)
+
+	hdr := mockCli.putHeaders[0]
+	require.Equal(t, object.TypeLock, hdr.Type())
+
+	hdrCnr, ok := hdr.ContainerID()
+	require.True(t, ok)
+	require.Equal(t, cnr, hdrCnr)
+
+	var owner user.ID
+	user.IDFromKey(&owner, p.key.PublicKey)
+	require.True(t, owner.Equals(*hdr.OwnerID()))
+
+	var info sdkClient.ObjectLockInfo
+	require.NoError(t, sdkClient.ReadLockInfo(&info, oidtest.ID(), hdr))
+	require.Equal(t, members, info.Members())
+	require.EqualValues(t, 100, info.ExpirationEpoch())
+}
+
+func newLockTestPool(t *testing.T) (*Pool, *mockClient) {
+	mockCli := newMockClient("peer0", *newPrivateKey(t))
+	mockCli.objects = make(map[oid.ID]object.Object)
+
+	opts := InitParameters{
+		key:        newPrivateKey(t),
+		nodeParams: []NodeParam{{1, "peer0", 1}},
+	}
+	opts.setClientBuilder(func(string) client {
+		return mockCli
+	})
+
+	p, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, p.Dial(context.Background()))
+	t.Cleanup(p.Close)
+
+	return p, mockCli
+}
+
+// storeTestLock stores LOCK object formed by the Pool in the mocked storage
+// and returns its address.
+func storeTestLock(t *testing.T, p *Pool, mockCli *mockClient, cnr cid.ID, exp uint64, members ...oid.ID) oid.Address {
+	var prm PrmObjectLock
+	prm.SetContainerID(cnr)
+	prm.SetMembers(members...)
+	prm.SetExpirationEpoch(exp)
+
+	_, err := p.LockObjects(context.Background(), prm)
+	require.NoError(t, err)
+
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(oidtest.ID())
+
+	mockCli.objects[addr.Object()] = mockCli.putHeaders[len(mockCli.putHeaders)-1]
+	mockCli.searchResult = append(mockCli.searchResult, addr.Object())
+
+	return addr
+}
+
+func TestPool_ExtendObjectLock(t *testing.T) {
+	p, mockCli := newLockTestPool(t)
+
+	cnr := cidtest.ID()
+	members := []oid.ID{oidtest.ID(), oidtest.ID()}
+	addr := storeTestLock(t, p, mockCli, cnr, 100, members...)
+	puts := len(mockCli.putHeaders)
+
+	var prm PrmObjectExtendLock
+	prm.SetExpirationEpoch(200)
+
+	var unknown oid.Address
+	unknown.SetContainer(cnr)
+	unknown.SetObject(oidtest.ID())
+	prm.SetAddress(unknown)
+
+	_, err := p.ExtendObjectLock(context.Background(), prm)
+	require.ErrorIs(t, err, apistatus.ErrObjectNotFound)
+
+	prm.SetAddress(addr)
+
+	for _, exp := range []uint64{50, 100} {
+		prm.SetExpirationEpoch(exp)
+
+		_, err = p.ExtendObjectLock(context.Background(), prm)
+		require.ErrorContains(t, err, "is not after the current one")
+		require.Len(t, mockCli.putHeaders, puts)
+	}
+
+	prm.SetExpirationEpoch(200)
+
+	res, err := p.ExtendObjectLock(context.Background(), prm)
+	require.NoError(t, err)
+	require.EqualValues(t, 200, res.ExpirationEpoch())
+	require.Len(t, mockCli.putHeaders, puts+1)
+
+	hdr := mockCli.putHeaders[puts]
+	require.Equal(t, object.TypeLock, hdr.Type())
+
+	hdrCnr, ok := hdr.ContainerID()
+	require.True(t, ok)
+	require.Equal(t, cnr, hdrCnr)
+
+	var info sdkClient.ObjectLockInfo
+	require.NoError(t, sdkClient.ReadLockInfo(&info, res.LockID(), hdr))
+	require.Equal(t, members, info.Members())
+	require.EqualValues(t, 200, info.ExpirationEpoch())
+}
+
+func TestPool_ListObjectLocks(t *testing.T) {
+	p, mockCli := newLockTestPool(t)
+	mockCli.netInfo.SetCurrentEpoch(150)
+
+	cnr := cidtest.ID()
+	obj, other := oidtest.ID(), oidtest.ID()
+
+	storeTestLock(t, p, mockCli, cnr, 100, obj) // expired
+	active := storeTestLock(t, p, mockCli, cnr, 200, other, obj)
+	storeTestLock(t, p, mockCli, cnr, 200, other) // another object
+	permanent := storeTestLock(t, p, mockCli, cnr, 0, obj)
+
+	// lock is removed after the search
+	mockCli.searchResult = append(mockCli.searchResult, oidtest.ID())
+
+	var addr oid.Address
+	addr.SetContainer(cnr)
+	addr.SetObject(obj)
+
+	var prm PrmObjectListLocks
+	prm.SetAddress(addr)
+
+	res, err := p.ListObjectLocks(context.Background(), prm)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	require.Equal(t, active.Object(), res[0].ID())
+	require.EqualValues(t, 200, res[0].ExpirationEpoch())
+	require.Equal(t, []oid.ID{other, obj}, res[0].Members())
+
+	require.Equal(t, permanent.Object(), res[1].ID())
+	require.Zero(t, res[1].ExpirationEpoch())
+	require.Equal(t, []oid.ID{obj}, res[1].Members())
+}
@@ -0,0 +1,281 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNodeExists indicates that node with the given address is already a member of the Pool.
+var ErrNodeExists = errors.New("node already exists")
+
+// errPoolNotDialed is returned on membership changes of the Pool which has not been dialed yet.
+var errPoolNotDialed = errors.New("pool is not dialed")
+
+// AddNode connects to the node and adds it to the Pool. The node starts
+// receiving requests right after the call. Sessions and health status of the
+// other nodes are kept.
+//
+// Returns ErrNodeExists if node with the same address is already in the Pool.
+// The node is not added if the connection can't be established. Returns
+// ErrPoolClosed after the Pool shutdown.
+//
+// The Pool MUST be dialed.
+func (p *Pool) AddNode(ctx context.Context, prm NodeParam) error {
+	return p.addNode(ctx, prm, false)
+}
+
+func (p *Pool) addNode(ctx context.Context, prm NodeParam, discovered bool) (err error) {
+	if prm.address == "" {
+		return errors.New("missing node address")
+	}
+
+	if prm.weight <= 0 {
+		return fmt.Errorf("non-positive node weight %v", prm.weight)
+	}
+
+	if p.ops.isClosed() {
+		return ErrPoolClosed
+	}
+
+	p.membersLock.RLock()
+	dialed, exists := p.innerPools != nil, p.hasNode(prm.address)
+	p.membersLock.RUnlock()
+
+	if !dialed {
+		return errPoolNotDialed
+	} else if exists {
+		return ErrNodeExists
+	}
+
+	cl, err := p.dialNode(ctx, prm.address)
+	defer func() {
+		// client is not shared with the Pool until the node is added
+		if err != nil && cl != nil {
+			_ = cl.close()
+		}
+	}()
+
+	if err != nil {
+		return fmt.Errorf("dial node: %w", err)
+	}
+
+	p.membersLock.Lock()
+	defer p.membersLock.Unlock()
+
+	// Pool could be shut down or node could be added concurrently while dialing.
+	// Shutdown closes clients of the Pool members, so the check under the lock
+	// guarantees the client is closed either here or by Shutdown.
+	if p.ops.isClosed() {
+		return ErrPoolClosed
+	} else if p.hasNode(prm.address) {
+		return ErrNodeExists
+	}
+
+	clients := p.clientsByAddress()
+
+	// node without requests would be preferred over any measured one, so it's
+	// considered as an average node of its group until the first request
+	cl.seedLatency(p.averageLatency(prm.priority, clients))
+	clients[prm.address] = cl
+
+	nodeParams := make([]NodeParam, 0, len(p.nodeParams)+1)
+	nodeParams = append(nodeParams, p.nodeParams...)
+	nodeParams = append(nodeParams, prm)
+
+	if err = p.applyMembers(nodeParams, clients); err != nil {
+		return err
+	}
+
+	if discovered {
+		p.discovered[prm.address] = struct{}{}
+	}
+
+	return nil
+}
+
+// RemoveNode removes the node from the Pool. The node stops receiving new
+// requests right after the call, while the requests in progress are completed.
+// Connection to the node is closed after that.
+//
+// Returns ErrUnknownNode if there is no node with the given address in the
+// Pool. The last node of the Pool can't be removed. Returns ErrPoolClosed
+// after the Pool shutdown.
+//
+// The Pool MUST be dialed.
+func (p *Pool) RemoveNode(address string) error {
+	p.membersLock.Lock()
+	defer p.membersLock.Unlock()
+
+	if p.ops.isClosed() {
+		return ErrPoolClosed
+	} else if p.innerPools == nil {
+		return errPoolNotDialed
+	}
+
+	nodeParams := make([]NodeParam, 0, len(p.nodeParams))
+	for i := range p.nodeParams {
+		if p.nodeParams[i].address != address {
+			nodeParams = append(nodeParams, p.nodeParams[i])
+		}
+	}
+
+	if len(nodeParams) == len(p.nodeParams) {
+		return ErrUnknownNode
+	} else if len(nodeParams) == 0 {
+		return errors.New("last node can't be removed")
+	}
+
+	clients := p.clientsByAddress()
+	removed := clients[address]
+	delete(clients, address)
+
+	if err := p.applyMembers(nodeParams, clients); err != nil {
+		return err
+	}
+
+	delete(p.discovered, address)
+	p.cache.DeleteByPrefix(address)
+
+	// removed client can still be used by the operations in progress
+	drained := p.ops.drain()
+	go func() {
+		<-drained
+		_ = removed.close()
+	}()
+
+	return nil
+}
+
+// UpdateWeight changes weight of the node within its priority group (see
+// NodeParam.SetWeight). New weight is taken into account right after the call.
+//
+// Returns ErrUnknownNode if there is no node with the given address in the Pool.
+// Returns ErrPoolClosed after the Pool shutdown.
+//
+// The Pool MUST be dialed.
+func (p *Pool) UpdateWeight(address string, weight float64) error {
+	if weight <= 0 {
+		return fmt.Errorf("non-positive node weight %v", weight)
+	}
+
+	p.membersLock.Lock()
+	defer p.membersLock.Unlock()
+
+	if p.ops.isClosed() {
+		return ErrPoolClosed
+	} else if p.innerPools == nil {
+		return errPoolNotDialed
+	}
+
+	var found bool
+
+	nodeParams := make([]NodeParam, len(p.nodeParams))
+	for i := range p.nodeParams {
+		nodeParams[i] = p.nodeParams[i]
+		if nodeParams[i].address == address {
+			nodeParams[i].weight = weight
+			found = true
+		}
+	}
+
+	if !found {
+		return ErrUnknownNode
+	}
+
+	return p.applyMembers(nodeParams, p.clientsByAddress())
+}
+
+// hasNode checks if the node with the given address is a member of the Pool.
+// Must be called under membersLock.
+func (p *Pool) hasNode(address string) bool {
+	for i := range p.nodeParams {
+		if p.nodeParams[i].address == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// averageLatency returns average latency of the nodes with the given priority
+// which have been requested already. Returns zero if there are no such nodes.
+// Must be called under membersLock.
+func (p *Pool) averageLatency(priority int, clients map[string]client) time.Duration {
+	var (
+		sum time.Duration
+		n   int
+	)
+
+	for i := range p.nodeParams {
+		if p.nodeParams[i].priority != priority {
+			continue
+		}
+
+		if cl, ok := clients[p.nodeParams[i].address]; ok {
+			if l := cl.latency(); l > 0 {
+				sum += l
+				n++
+			}
+		}
+	}
+
+	if n == 0 {
+		return 0
+	}
+
+	return sum / time.Duration(n)
+}
+
+// clientsByAddress returns clients of the Pool indexed by the node address.
+// Must be called under membersLock.
+func (p *Pool) clientsByAddress() map[string]client {
+	res := make(map[string]client)
+
+	for _, inner := range p.innerPools {
+		inner.lock.RLock()
+		for _, cl := range inner.clients {
+			res[cl.address()] = cl
+		}
+		inner.lock.RUnlock()
+	}
+
+	return res
+}
+
+// applyMembers replaces inner pools with the ones formed from the given nodes
+// using the given clients. Clients are shared with the replaced inner pools,
+// so their health status and statistic are kept. Samplers of the new inner
+// pools don't select unavailable nodes until the next rebalance.
+//
+// Must be called under membersLock.
+func (p *Pool) applyMembers(nodeParams []NodeParam, clients map[string]client) error {
+	nodesParams, err := adjustNodeParams(nodeParams)
+	if err != nil {
+		return err
+	}
+
+	inner := make([]*innerPool, len(nodesParams))
+
+	for i, params := range nodesParams {
+		inner[i] = &innerPool{
+			clients:    make([]client, len(params.addresses)),
+			powerOfTwo: p.powerOfTwo,
+		}
+
+		for j, addr := range params.addresses {
+			inner[i].clients[j] = clients[addr]
+		}
+
+		weights := make([]float64, len(params.addresses))
+		p.effectiveWeights(weights, inner[i].clients, params.weights)
+		inner[i].resetSampler(weights)
+	}
+
+	p.nodeParams = nodeParams
+	p.rebalanceParams.nodesParams = nodesParams
+	p.innerPools = inner
+
+	return nil
+}
@@ -0,0 +1,304 @@
+package pool
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool_Membership(t *testing.T) {
+	key := newPrivateKey(t)
+
+	opts := InitParameters{
+		key: key,
+		nodeParams: []NodeParam{
+			{1, "peer0", 1},
+		},
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.setClientBuilder(func(addr string) client {
+		mockCli := newMockClient(addr, *newPrivateKey(t))
+		if addr == "unreachable" {
+			mockCli.errOnDial()
+		}
+		return mockCli
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, pool.AddNode(ctx, NewNodeParam(1, "peer1", 1)), errPoolNotDialed)
+
+	err = pool.Dial(ctx)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	nodeWeight := func(addr string) float64 {
+		node, err := pool.Statistic().Node(addr)
+		require.NoError(t, err)
+		return node.Weight()
+	}
+
+	require.NoError(t, pool.AddNode(ctx, NewNodeParam(1, "peer1", 3)))
+	require.ErrorIs(t, pool.AddNode(ctx, NewNodeParam(2, "peer1", 1)), ErrNodeExists)
+	require.Error(t, pool.AddNode(ctx, NewNodeParam(1, "unreachable", 1)))
+	require.Error(t, pool.AddNode(ctx, NewNodeParam(1, "peer2", 0)))
+
+	require.Len(t, pool.Statistic().Nodes(), 2)
+	require.Equal(t, 0.25, nodeWeight("peer0"))
+	require.Equal(t, 0.75, nodeWeight("peer1"))
+
+	_, ok := pool.cache.Get(formCacheKey("peer1", key))
+	require.True(t, ok)
+
+	require.NoError(t, pool.UpdateWeight("peer1", 1))
+	require.Equal(t, 0.5, nodeWeight("peer1"))
+	require.ErrorIs(t, pool.UpdateWeight("peer2", 1), ErrUnknownNode)
+
+	require.NoError(t, pool.AddNode(ctx, NewNodeParam(2, "peer2", 1)))
+
+	node, err := pool.Statistic().Node("peer2")
+	require.NoError(t, err)
+	require.Equal(t, 2, node.Priority())
+
+	require.NoError(t, pool.RemoveNode("peer0"))
+	require.ErrorIs(t, pool.RemoveNode("peer0"), ErrUnknownNode)
+
+	_, err = pool.Statistic().Node("peer0")
+	require.ErrorIs(t, err, ErrUnknownNode)
+
+	_, ok = pool.cache.Get(formCacheKey("peer0", key))
+	require.False(t, ok)
+
+	for i := 0; i < 10; i++ {
+		cp, err := pool.connection()
+		require.NoError(t, err)
+		require.Equal(t, "peer1", cp.address())
+	}
+
+	require.NoError(t, pool.RemoveNode("peer1"))
+	require.Error(t, pool.RemoveNode("peer2"))
+
+	cp, err := pool.connection()
+	require.NoError(t, err)
+	require.Equal(t, "peer2", cp.address())
+}
+
+func TestPool_MembershipUnderLoad(t *testing.T) {
+	opts := InitParameters{
+		key: newPrivateKey(t),
+		nodeParams: []NodeParam{
+			{1, "peer0", 1},
+		},
+		clientRebalanceInterval: 10 * time.Millisecond,
+	}
+	opts.setClientBuilder(func(addr string) client {
+		return newMockClient(addr, *newPrivateKey(t))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	err = pool.Dial(ctx)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	done := make(chan struct{})
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				_, err := pool.connection()
+				require.NoError(t, err)
+				_ = pool.Statistic()
+			}
+		}()
+	}
+
+	for i := 0; i < 50; i++ {
+		require.NoError(t, pool.AddNode(ctx, NewNodeParam(i%3, "peer1", float64(i+1))))
+		require.NoError(t, pool.UpdateWeight("peer0", float64(i+1)))
+		require.NoError(t, pool.RemoveNode("peer1"))
+	}
+
+	close(done)
+	wg.Wait()
+}
+
+func TestPool_MembershipClients(t *testing.T) {
+	var (
+		mtx     sync.Mutex
+		clients = make(map[string][]*mockClient)
+		pool    *Pool
+
+		resShutdown ResShutdown
+		errShutdown error
+	)
+
+	opts := InitParameters{
+		key: newPrivateKey(t),
+		nodeParams: []NodeParam{
+			{1, "peer0", 1},
+			{2, "peer1", 1},
+		},
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.setClientBuilder(func(addr string) client {
+		mockCli := newMockClient(addr, *newPrivateKey(t))
+		mockCli.getPayload = []byte("payload")
+
+		switch addr {
+		case "unreachable":
+			mockCli.errOnDial()
+		case "no session":
+			mockCli.errOnCreateSession()
+		}
+
+		mtx.Lock()
+		clients[addr] = append(clients[addr], mockCli)
+		first := len(clients[addr]) == 1
+		mtx.Unlock()
+
+		if addr == "concurrent" && first {
+			// node is added while the first client is dialing
+			require.NoError(t, pool.AddNode(context.Background(), NewNodeParam(1, addr, 1)))
+		}
+
+		if addr == "late" {
+			// Pool is shut down while the client is dialing
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			resShutdown, errShutdown = pool.Shutdown(ctx)
+		}
+
+		return mockCli
+	})
+
+	ctx := context.Background()
+
+	var err error
+
+	pool, err = NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, pool.Dial(ctx))
+	t.Cleanup(pool.Close)
+
+	t.Run("dial failure", func(t *testing.T) {
+		require.Error(t, pool.AddNode(ctx, NewNodeParam(1, "unreachable", 1)))
+		require.Error(t, pool.AddNode(ctx, NewNodeParam(1, "no session", 1)))
+
+		require.True(t, clients["unreachable"][0].closed.Load())
+		require.True(t, clients["no session"][0].closed.Load())
+	})
+
+	t.Run("concurrent add", func(t *testing.T) {
+		require.ErrorIs(t, pool.AddNode(ctx, NewNodeParam(1, "concurrent", 1)), ErrNodeExists)
+
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		// the first client lost the race, the second one is in use
+		require.Len(t, clients["concurrent"], 2)
+		require.True(t, clients["concurrent"][0].closed.Load())
+		require.False(t, clients["concurrent"][1].closed.Load())
+
+		_, err := pool.Statistic().Node("concurrent")
+		require.NoError(t, err)
+	})
+
+	t.Run("removal", func(t *testing.T) {
+		require.NoError(t, pool.RemoveNode("concurrent"))
+
+		var prm PrmObjectGet
+		prm.SetAddress(oid.Address{})
+
+		// operation in progress on peer0
+		res, err := pool.GetObject(ctx, prm)
+		require.NoError(t, err)
+
+		require.NoError(t, pool.RemoveNode("peer0"))
+
+		time.Sleep(50 * time.Millisecond)
+		require.False(t, clients["peer0"][0].closed.Load())
+
+		require.NoError(t, res.Payload.Close())
+
+		require.Eventually(t, func() bool {
+			return clients["peer0"][0].closed.Load() && clients["concurrent"][1].closed.Load()
+		}, time.Second, 10*time.Millisecond)
+		require.False(t, clients["peer1"][0].closed.Load())
+	})
+
+	t.Run("shutdown", func(t *testing.T) {
+		require.ErrorIs(t, pool.AddNode(ctx, NewNodeParam(1, "late", 1)), ErrPoolClosed)
+
+		// dialing is not an operation to be aborted
+		require.NoError(t, errShutdown)
+		require.Zero(t, resShutdown.Aborted())
+		require.True(t, clients["late"][0].closed.Load())
+
+		require.ErrorIs(t, pool.AddNode(ctx, NewNodeParam(1, "peer2", 1)), ErrPoolClosed)
+		require.ErrorIs(t, pool.UpdateWeight("peer1", 2), ErrPoolClosed)
+		require.ErrorIs(t, pool.RemoveNode("peer1"), ErrPoolClosed)
+
+		_, ok := clients["peer2"]
+		require.False(t, ok)
+	})
+}
+
+func TestPool_AddNodeLatency(t *testing.T) {
+	clients := make(map[string]*mockClient)
+
+	opts := InitParameters{
+		key: newPrivateKey(t),
+		nodeParams: []NodeParam{
+			{1, "peer0", 1},
+			{1, "peer1", 1},
+			{2, "peer2", 1},
+		},
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.setClientBuilder(func(addr string) client {
+		mockCli := newMockClient(addr, *newPrivateKey(t))
+		clients[addr] = mockCli
+		return mockCli
+	})
+
+	ctx := context.Background()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, pool.Dial(ctx))
+	t.Cleanup(pool.Close)
+
+	clients["peer0"].observeLatency(10 * time.Millisecond)
+	clients["peer1"].observeLatency(30 * time.Millisecond)
+	clients["peer2"].observeLatency(time.Second)
+
+	// new node is considered as an average one of its group
+	require.NoError(t, pool.AddNode(ctx, NewNodeParam(1, "peer3", 1)))
+	require.Equal(t, 20*time.Millisecond, clients["peer3"].latency())
+
+	// there are no measured nodes in the group
+	require.NoError(t, pool.AddNode(ctx, NewNodeParam(3, "peer4", 1)))
+	require.Zero(t, clients["peer4"].latency())
+}
@@ -1,13 +1,18 @@
 package pool
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
 	"errors"
+	"io"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	sessionv2 "github.com/nspcc-dev/neofs-api-go/v2/session"
 	"github.com/nspcc-dev/neofs-sdk-go/accounting"
+	sdkClient "github.com/nspcc-dev/neofs-sdk-go/client"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -17,6 +22,7 @@ import (
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"go.uber.org/atomic"
 )
 
 type mockClient struct {
@@ -27,13 +33,38 @@ type mockClient struct {
 	errorOnCreateSession bool
 	errorOnEndpointInfo  bool
 	errorOnNetworkInfo   bool
+	errorOnListContainer bool
+	errorOnObjectSearch  bool
 	stOnGetObject        apistatus.Status
+	netmapMaintenance    *atomic.Bool
+
+	netMapMtx sync.Mutex
+	netMap    netmap.NetMap
+
+	headDelay     time.Duration
+	headCanceled  *atomic.Bool
+	headSplitInfo *object.SplitInfo
+
+	getPayload []byte
+	closed     *atomic.Bool
+
+	putHeaders []object.Object
+
+	// objects are returned by GET if set, search returns searchResult
+	objects      map[oid.ID]object.Object
+	searchResult []oid.ID
+
+	netInfo      netmap.NetworkInfo
+	netInfoDelay time.Duration
 }
 
 func newMockClient(addr string, key ecdsa.PrivateKey) *mockClient {
 	return &mockClient{
 		key:                 key,
 		clientStatusMonitor: newClientStatusMonitor(addr, 10),
+		netmapMaintenance:   atomic.NewBool(false),
+		headCanceled:        atomic.NewBool(false),
+		closed:              atomic.NewBool(false),
 	}
 }
 
@@ -53,6 +84,14 @@ func (m *mockClient) errOnNetworkInfo() {
 	m.errorOnEndpointInfo = true
 }
 
+func (m *mockClient) errOnListContainer() {
+	m.errorOnListContainer = true
+}
+
+func (m *mockClient) errOnObjectSearch() {
+	m.errorOnObjectSearch = true
+}
+
 func (m *mockClient) errOnDial() {
 	m.errorOnDial = true
 	m.errOnCreateSession()
@@ -60,6 +99,19 @@ func (m *mockClient) errOnDial() {
 	m.errOnNetworkInfo()
 }
 
+// setNetmapMaintenance sets maintenance flag of the node info returned by
+// endpointInfo.
+func (m *mockClient) setNetmapMaintenance(maintenance bool) {
+	m.netmapMaintenance.Store(maintenance)
+}
+
+// setNetMap sets network map returned by netMapSnapshot.
+func (m *mockClient) setNetMap(nodes ...netmap.NodeInfo) {
+	m.netMapMtx.Lock()
+	m.netMap.SetNodes(nodes)
+	m.netMapMtx.Unlock()
+}
+
 func (m *mockClient) statusOnGetObject(st apistatus.Status) {
 	m.stOnGetObject = st
 }
@@ -86,7 +138,11 @@ func (m *mockClient) containerGet(context.Context, PrmContainerGet) (container.C
 }
 
 func (m *mockClient) containerList(context.Context, PrmContainerList) ([]cid.ID, error) {
-	return nil, nil
+	if m.errorOnListContainer {
+		return nil, m.handleMethodError(methodContainerList, nil, errors.New("error"))
+	}
+
+	return nil, m.handleMethodError(methodContainerList, nil, nil)
 }
 
 func (m *mockClient) containerDelete(context.Context, PrmContainerDelete) error {
@@ -109,20 +165,40 @@ func (m *mockClient) endpointInfo(context.Context, prmEndpointInfo) (netmap.Node
 	}
 
 	ni.SetNetworkEndpoints(m.addr)
+
+	if m.netmapMaintenance.Load() {
+		ni.SetMaintenance()
+	}
+
+	m.setMaintenance(ni.IsMaintenance())
+
 	return ni, nil
 }
 
-func (m *mockClient) networkInfo(context.Context, prmNetworkInfo) (netmap.NetworkInfo, error) {
-	var ni netmap.NetworkInfo
-
+func (m *mockClient) networkInfo(ctx context.Context, _ prmNetworkInfo) (netmap.NetworkInfo, error) {
 	if m.errorOnNetworkInfo {
-		return ni, m.handleError(nil, errors.New("error"))
+		return netmap.NetworkInfo{}, m.handleError(nil, errors.New("error"))
 	}
 
-	return ni, nil
+	if m.netInfoDelay > 0 {
+		select {
+		case <-time.After(m.netInfoDelay):
+		case <-ctx.Done():
+			return netmap.NetworkInfo{}, ctx.Err()
+		}
+	}
+
+	return m.netInfo, nil
 }
 
-func (m *mockClient) objectPut(context.Context, PrmObjectPut) (oid.ID, error) {
+func (m *mockClient) netMapSnapshot(context.Context, prmNetMapSnapshot) (netmap.NetMap, error) {
+	m.netMapMtx.Lock()
+	defer m.netMapMtx.Unlock()
+	return m.netMap, nil
+}
+
+func (m *mockClient) objectPut(_ context.Context, prm PrmObjectPut) (oid.ID, error) {
+	m.putHeaders = append(m.putHeaders, prm.hdr)
 	return oid.ID{}, nil
 }
 
@@ -130,10 +206,26 @@ func (m *mockClient) objectDelete(context.Context, PrmObjectDelete) error {
 	return nil
 }
 
-func (m *mockClient) objectGet(context.Context, PrmObjectGet) (ResGetObject, error) {
+func (m *mockClient) objectGet(_ context.Context, prm PrmObjectGet) (ResGetObject, error) {
 	var res ResGetObject
 
+	if m.objects != nil {
+		obj, ok := m.objects[prm.addr.Object()]
+		if !ok {
+			return res, m.handleError(apistatus.ErrObjectNotFound, nil)
+		}
+
+		res.Header = obj
+		res.Payload = io.NopCloser(bytes.NewReader(obj.Payload()))
+
+		return res, nil
+	}
+
 	if m.stOnGetObject == nil {
+		if m.getPayload != nil {
+			res.Payload = io.NopCloser(bytes.NewReader(m.getPayload))
+		}
+
 		return res, nil
 	}
 
@@ -141,16 +233,66 @@ func (m *mockClient) objectGet(context.Context, PrmObjectGet) (ResGetObject, err
 	return res, m.handleError(status, nil)
 }
 
-func (m *mockClient) objectHead(context.Context, PrmObjectHead) (object.Object, error) {
-	return object.Object{}, nil
+func (m *mockClient) objectHead(ctx context.Context, prm PrmObjectHead) (object.Object, error) {
+	if m.headDelay > 0 {
+		select {
+		case <-time.After(m.headDelay):
+		case <-ctx.Done():
+			err := m.handleContextError(ctx, methodObjectHead, nil, ctx.Err())
+			m.headCanceled.Store(true)
+			return object.Object{}, err
+		}
+	}
+
+	if m.headSplitInfo != nil && prm.raw {
+		return object.Object{}, object.NewSplitInfoError(m.headSplitInfo)
+	}
+
+	var obj object.Object
+	obj.SetPayload([]byte(m.addr))
+
+	return obj, nil
 }
 
 func (m *mockClient) objectRange(context.Context, PrmObjectRange) (ResObjectRange, error) {
 	return ResObjectRange{}, nil
 }
 
+func (m *mockClient) objectHash(context.Context, PrmObjectHash) ([][]byte, error) {
+	return nil, nil
+}
+
 func (m *mockClient) objectSearch(context.Context, PrmObjectSearch) (ResObjectSearch, error) {
-	return ResObjectSearch{}, nil
+	if m.errorOnObjectSearch {
+		return ResObjectSearch{}, m.handleMethodError(methodObjectSearch, nil, errors.New("error"))
+	}
+
+	return ResObjectSearch{r: &mockListReader{ids: m.searchResult}}, m.handleMethodError(methodObjectSearch, nil, nil)
+}
+
+type mockListReader struct {
+	ids []oid.ID
+}
+
+func (x *mockListReader) Read(buf []oid.ID) (int, bool) {
+	n := copy(buf, x.ids)
+	x.ids = x.ids[n:]
+	return n, len(x.ids) > 0
+}
+
+func (x *mockListReader) Iterate(f func(oid.ID) bool) error {
+	for len(x.ids) > 0 {
+		id := x.ids[0]
+		x.ids = x.ids[1:]
+		if f(id) {
+			break
+		}
+	}
+	return nil
+}
+
+func (x *mockListReader) Close() (*sdkClient.ResObjectSearch, error) {
+	return nil, nil
 }
 
 func (m *mockClient) sessionCreate(context.Context, prmCreateSession) (resCreateSession, error) {
@@ -187,3 +329,8 @@ func (m *mockClient) restartIfUnhealthy(ctx context.Context) (healthy bool, chan
 	}
 	return
 }
+
+func (m *mockClient) close() error {
+	m.closed.Store(true)
+	return nil
+}
@@ -28,6 +28,7 @@ import (
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object/relations"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/telemetry"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -55,6 +56,8 @@ type client interface {
 	endpointInfo(context.Context, prmEndpointInfo) (netmap.NodeInfo, error)
 	// see clientWrapper.networkInfo.
 	networkInfo(context.Context, prmNetworkInfo) (netmap.NetworkInfo, error)
+	// see clientWrapper.netMapSnapshot.
+	netMapSnapshot(context.Context, prmNetMapSnapshot) (netmap.NetMap, error)
 	// see clientWrapper.objectPut.
 	objectPut(context.Context, PrmObjectPut) (oid.ID, error)
 	// see clientWrapper.objectDelete.
@@ -65,6 +68,8 @@ type client interface {
 	objectHead(context.Context, PrmObjectHead) (object.Object, error)
 	// see clientWrapper.objectRange.
 	objectRange(context.Context, PrmObjectRange) (ResObjectRange, error)
+	// see clientWrapper.objectHash.
+	objectHash(context.Context, PrmObjectHash) ([][]byte, error)
 	// see clientWrapper.objectSearch.
 	objectSearch(context.Context, PrmObjectSearch) (ResObjectSearch, error)
 	// see clientWrapper.sessionCreate.
@@ -76,6 +81,8 @@ type client interface {
 	dial(ctx context.Context) error
 	// see clientWrapper.restartIfUnhealthy.
 	restartIfUnhealthy(ctx context.Context) (bool, bool)
+	// see clientWrapper.close.
+	close() error
 }
 
 // clientStatus provide access to some metrics for connection.
@@ -84,6 +91,11 @@ type clientStatus interface {
 	isHealthy() bool
 	// setUnhealthy marks client as unhealthy.
 	setUnhealthy()
+	// isMaintenance checks if the node is under maintenance. Such nodes are
+	// drained from the request routing until they are re-probed.
+	isMaintenance() bool
+	// setMaintenance marks the node as being (or not being) under maintenance.
+	setMaintenance(bool)
 	// address return address of endpoint.
 	address() string
 	// currentErrorRate returns current errors rate.
@@ -94,6 +106,19 @@ type clientStatus interface {
 	overallErrorRate() uint64
 	// methodsStatus returns statistic for all used methods.
 	methodsStatus() []statusSnapshot
+	// latency returns exponentially weighted moving average of the request
+	// latency. Returns zero if there were no requests yet and latency was not
+	// seeded.
+	latency() time.Duration
+	// seedLatency sets initial latency of the node which has no requests yet.
+	// The first request replaces seeded value.
+	seedLatency(time.Duration)
+	// allowRequest checks if circuit breaker passes the request of the given
+	// method to the node. methodLast means request of unknown method.
+	allowRequest(MethodIndex) bool
+	// circuitState returns state of the node circuit breaker. If circuit
+	// breakers are maintained per method, the most restrictive state is returned.
+	circuitState() CircuitState
 }
 
 // errPoolClientUnhealthy is an error to indicate that client in pool is unhealthy.
@@ -103,14 +128,23 @@ var errPoolClientUnhealthy = errors.New("pool client unhealthy")
 type clientStatusMonitor struct {
 	addr           string
 	healthy        *atomic.Bool
+	maintenance    *atomic.Bool
 	errorThreshold uint32
 
 	mu                sync.RWMutex // protect counters
 	currentErrorCount uint32
 	overallErrorCount uint64
+	latencyEWMA       float64
+	latencyMeasured   bool
 	methods           []*methodStatus
+
+	breakers *circuitBreakers
 }
 
+// latencySmoothingFactor is a weight of the latest request in the exponentially
+// weighted moving average of the request latency.
+const latencySmoothingFactor = 0.2
+
 // methodStatus provide statistic for specific method.
 type methodStatus struct {
 	name string
@@ -137,11 +171,14 @@ const (
 	methodContainerSetEACL
 	methodEndpointInfo
 	methodNetworkInfo
+	methodNetMapSnapshot
 	methodObjectPut
 	methodObjectDelete
 	methodObjectGet
 	methodObjectHead
 	methodObjectRange
+	methodObjectHash
+	methodObjectSearch
 	methodSessionCreate
 	methodLast
 )
@@ -167,6 +204,8 @@ func (m MethodIndex) String() string {
 		return "endpointInfo"
 	case methodNetworkInfo:
 		return "networkInfo"
+	case methodNetMapSnapshot:
+		return "netMapSnapshot"
 	case methodObjectPut:
 		return "objectPut"
 	case methodObjectDelete:
@@ -177,6 +216,10 @@ func (m MethodIndex) String() string {
 		return "objectHead"
 	case methodObjectRange:
 		return "objectRange"
+	case methodObjectHash:
+		return "objectHash"
+	case methodObjectSearch:
+		return "objectSearch"
 	case methodSessionCreate:
 		return "sessionCreate"
 	case methodLast:
@@ -195,6 +238,7 @@ func newClientStatusMonitor(addr string, errorThreshold uint32) clientStatusMoni
 	return clientStatusMonitor{
 		addr:           addr,
 		healthy:        atomic.NewBool(true),
+		maintenance:    atomic.NewBool(false),
 		errorThreshold: errorThreshold,
 		methods:        methods,
 	}
@@ -217,6 +261,7 @@ func (m *methodStatus) incRequests(elapsed time.Duration) {
 type clientWrapper struct {
 	clientMutex sync.RWMutex
 	client      *sdkClient.Client
+	dialed      bool
 	prm         wrapperPrm
 
 	clientStatusMonitor
@@ -231,6 +276,8 @@ type wrapperPrm struct {
 	errorThreshold          uint32
 	responseInfoCallback    func(sdkClient.ResponseMetaInfo) error
 	poolRequestInfoCallback func(RequestInfo)
+	exporter                telemetry.Exporter
+	circuitBreaker          CircuitBreakerParams
 }
 
 // setAddress sets endpoint to connect in NeoFS network.
@@ -269,12 +316,23 @@ func (x *wrapperPrm) setResponseInfoCallback(f func(sdkClient.ResponseMetaInfo)
 	x.responseInfoCallback = f
 }
 
+// setExporter sets telemetry exporter of the underlying sdkClient.Client.
+func (x *wrapperPrm) setExporter(e telemetry.Exporter) {
+	x.exporter = e
+}
+
+// setCircuitBreaker sets parameters of the node circuit breakers.
+func (x *wrapperPrm) setCircuitBreaker(prm CircuitBreakerParams) {
+	x.circuitBreaker = prm
+}
+
 // newWrapper creates a clientWrapper that implements the client interface.
 func newWrapper(prm wrapperPrm) *clientWrapper {
 	var cl sdkClient.Client
 	var prmInit sdkClient.PrmInit
 	prmInit.SetDefaultPrivateKey(prm.key)
 	prmInit.SetResponseInfoCallback(prm.responseInfoCallback)
+	prmInit.SetExporter(prm.exporter)
 
 	cl.Init(prmInit)
 
@@ -283,6 +341,7 @@ func newWrapper(prm wrapperPrm) *clientWrapper {
 		clientStatusMonitor: newClientStatusMonitor(prm.address, prm.errorThreshold),
 		prm:                 prm,
 	}
+	res.breakers = newCircuitBreakers(prm.address, prm.circuitBreaker)
 
 	return res
 }
@@ -307,14 +366,36 @@ func (c *clientWrapper) dial(ctx context.Context) error {
 		return err
 	}
 
+	c.clientMutex.Lock()
+	c.dialed = true
+	c.clientMutex.Unlock()
+
 	return nil
 }
 
+// close closes connection to the server. Client SHOULD NOT be used after
+// the call.
+func (c *clientWrapper) close() error {
+	c.clientMutex.Lock()
+	defer c.clientMutex.Unlock()
+
+	c.setUnhealthy()
+
+	if !c.dialed {
+		return nil
+	}
+
+	c.dialed = false
+
+	return c.client.Close()
+}
+
 // restartIfUnhealthy checks healthy status of client and recreate it if status is unhealthy.
 // Return current healthy status and indicating if status was changed by this function call.
 func (c *clientWrapper) restartIfUnhealthy(ctx context.Context) (healthy, changed bool) {
 	var wasHealthy bool
-	if _, err := c.endpointInfo(ctx, prmEndpointInfo{}); err == nil {
+	if _, err := c.endpointInfo(ctx, prmEndpointInfo{}); err == nil || errors.Is(err, apistatus.ErrNodeUnderMaintenance) {
+		// node under maintenance is alive, it is just not ready to serve requests
 		return true, false
 	} else if !errors.Is(err, errPoolClientUnhealthy) {
 		wasHealthy = true
@@ -324,6 +405,7 @@ func (c *clientWrapper) restartIfUnhealthy(ctx context.Context) (healthy, change
 	var prmInit sdkClient.PrmInit
 	prmInit.SetDefaultPrivateKey(c.prm.key)
 	prmInit.SetResponseInfoCallback(c.prm.responseInfoCallback)
+	prmInit.SetExporter(c.prm.exporter)
 
 	cl.Init(prmInit)
 
@@ -340,11 +422,23 @@ func (c *clientWrapper) restartIfUnhealthy(ctx context.Context) (healthy, change
 
 	c.clientMutex.Lock()
 	c.client = &cl
+	c.dialed = true
 	c.clientMutex.Unlock()
 
-	if _, err := cl.EndpointInfo(ctx, sdkClient.PrmEndpointInfo{}); err != nil {
+	res, err := cl.EndpointInfo(ctx, sdkClient.PrmEndpointInfo{})
+	if err == nil {
+		err = apistatus.ErrFromStatus(res.Status())
+	}
+
+	switch {
+	case errors.Is(err, apistatus.ErrNodeUnderMaintenance):
+		// node under maintenance is alive, it is just not ready to serve requests
+		c.setMaintenance(true)
+	case err != nil:
 		c.setUnhealthy()
 		return false, wasHealthy
+	default:
+		c.setMaintenance(res.NodeInfo().IsMaintenance())
 	}
 
 	c.setHealthy()
@@ -377,7 +471,7 @@ func (c *clientWrapper) balanceGet(ctx context.Context, prm PrmBalanceGet) (acco
 	if res != nil {
 		st = res.Status()
 	}
-	if err = c.handleError(st, err); err != nil {
+	if err = c.handleMethodError(methodBalanceGet, st, err); err != nil {
 		return accounting.Decimal{}, fmt.Errorf("balance get on client: %w", err)
 	}
 
@@ -399,7 +493,7 @@ func (c *clientWrapper) containerPut(ctx context.Context, prm PrmContainerPut) (
 	if res != nil {
 		st = res.Status()
 	}
-	if err = c.handleError(st, err); err != nil {
+	if err = c.handleMethodError(methodContainerPut, st, err); err != nil {
 		return cid.ID{}, fmt.Errorf("container put on client: %w", err)
 	}
 
@@ -410,7 +504,7 @@ func (c *clientWrapper) containerPut(ctx context.Context, prm PrmContainerPut) (
 	idCnr := res.ID()
 
 	err = waitForContainerPresence(ctx, c, idCnr, &prm.waitParams)
-	if err = c.handleError(nil, err); err != nil {
+	if err = c.handleMethodError(methodContainerPut, nil, err); err != nil {
 		return cid.ID{}, fmt.Errorf("wait container presence on client: %w", err)
 	}
 
@@ -434,7 +528,7 @@ func (c *clientWrapper) containerGet(ctx context.Context, prm PrmContainerGet) (
 	if res != nil {
 		st = res.Status()
 	}
-	if err = c.handleError(st, err); err != nil {
+	if err = c.handleMethodError(methodContainerGet, st, err); err != nil {
 		return container.Container{}, fmt.Errorf("container get on client: %w", err)
 	}
 
@@ -458,7 +552,7 @@ func (c *clientWrapper) containerList(ctx context.Context, prm PrmContainerList)
 	if res != nil {
 		st = res.Status()
 	}
-	if err = c.handleError(st, err); err != nil {
+	if err = c.handleMethodError(methodContainerList, st, err); err != nil {
 		return nil, fmt.Errorf("container list on client: %w", err)
 	}
 	return res.Containers(), nil
@@ -485,7 +579,7 @@ func (c *clientWrapper) containerDelete(ctx context.Context, prm PrmContainerDel
 	if res != nil {
 		st = res.Status()
 	}
-	if err = c.handleError(st, err); err != nil {
+	if err = c.handleMethodError(methodContainerDelete, st, err); err != nil {
 		return fmt.Errorf("container delete on client: %w", err)
 	}
 
@@ -513,7 +607,7 @@ func (c *clientWrapper) containerEACL(ctx context.Context, prm PrmContainerEACL)
 	if res != nil {
 		st = res.Status()
 	}
-	if err = c.handleError(st, err); err != nil {
+	if err = c.handleMethodError(methodContainerEACL, st, err); err != nil {
 		return eacl.Table{}, fmt.Errorf("get eacl on client: %w", err)
 	}
 
@@ -542,7 +636,7 @@ func (c *clientWrapper) containerSetEACL(ctx context.Context, prm PrmContainerSe
 	if res != nil {
 		st = res.Status()
 	}
-	if err = c.handleError(st, err); err != nil {
+	if err = c.handleMethodError(methodContainerSetEACL, st, err); err != nil {
 		return fmt.Errorf("set eacl on client: %w", err)
 	}
 
@@ -556,7 +650,7 @@ func (c *clientWrapper) containerSetEACL(ctx context.Context, prm PrmContainerSe
 	}
 
 	err = waitForEACLPresence(ctx, c, cIDp, &prm.table, &prm.waitParams)
-	if err = c.handleError(nil, err); err != nil {
+	if err = c.handleMethodError(methodContainerSetEACL, nil, err); err != nil {
 		return fmt.Errorf("wait eacl presence on client: %w", err)
 	}
 
@@ -577,11 +671,14 @@ func (c *clientWrapper) endpointInfo(ctx context.Context, _ prmEndpointInfo) (ne
 	if res != nil {
 		st = res.Status()
 	}
-	if err = c.handleError(st, err); err != nil {
+	if err = c.handleMethodError(methodEndpointInfo, st, err); err != nil {
 		return netmap.NodeInfo{}, fmt.Errorf("endpoint info on client: %w", err)
 	}
 
-	return res.NodeInfo(), nil
+	ni := res.NodeInfo()
+	c.setMaintenance(ni.IsMaintenance())
+
+	return ni, nil
 }
 
 // networkInfo invokes sdkClient.NetworkInfo parse response status to error and return result as is.
@@ -598,13 +695,34 @@ func (c *clientWrapper) networkInfo(ctx context.Context, _ prmNetworkInfo) (netm
 	if res != nil {
 		st = res.Status()
 	}
-	if err = c.handleError(st, err); err != nil {
+	if err = c.handleMethodError(methodNetworkInfo, st, err); err != nil {
 		return netmap.NetworkInfo{}, fmt.Errorf("network info on client: %w", err)
 	}
 
 	return res.Info(), nil
 }
 
+// netMapSnapshot invokes sdkClient.NetMapSnapshot parse response status to error and return result as is.
+func (c *clientWrapper) netMapSnapshot(ctx context.Context, _ prmNetMapSnapshot) (netmap.NetMap, error) {
+	cl, err := c.getClient()
+	if err != nil {
+		return netmap.NetMap{}, err
+	}
+
+	start := time.Now()
+	res, err := cl.NetMapSnapshot(ctx, sdkClient.PrmNetMapSnapshot{})
+	c.incRequests(time.Since(start), methodNetMapSnapshot)
+	var st apistatus.Status
+	if res != nil {
+		st = res.Status()
+	}
+	if err = c.handleMethodError(methodNetMapSnapshot, st, err); err != nil {
+		return netmap.NetMap{}, fmt.Errorf("network map snapshot on client: %w", err)
+	}
+
+	return res.NetMap(), nil
+}
+
 // objectPut writes object to NeoFS.
 func (c *clientWrapper) objectPut(ctx context.Context, prm PrmObjectPut) (oid.ID, error) {
 	cl, err := c.getClient()
@@ -627,7 +745,7 @@ func (c *clientWrapper) objectPut(ctx context.Context, prm PrmObjectPut) (oid.ID
 	start := time.Now()
 	wObj, err := cl.ObjectPutInit(ctx, cliPrm)
 	c.incRequests(time.Since(start), methodObjectPut)
-	if err = c.handleError(nil, err); err != nil {
+	if err = c.handleMethodError(methodObjectPut, nil, err); err != nil {
 		return oid.ID{}, fmt.Errorf("init writing on API client: %w", err)
 	}
 
@@ -659,7 +777,7 @@ func (c *clientWrapper) objectPut(ctx context.Context, prm PrmObjectPut) (oid.ID
 				if n > 0 {
 					start = time.Now()
 					successWrite := wObj.WritePayloadChunk(buf[:n])
-					c.incRequests(time.Since(start), methodObjectPut)
+					c.incStreamRequests(time.Since(start), methodObjectPut)
 					if !successWrite {
 						break
 					}
@@ -671,7 +789,7 @@ func (c *clientWrapper) objectPut(ctx context.Context, prm PrmObjectPut) (oid.ID
 					break
 				}
 
-				return oid.ID{}, fmt.Errorf("read payload: %w", c.handleError(nil, err))
+				return oid.ID{}, fmt.Errorf("read payload: %w", c.handleMethodError(methodObjectPut, nil, err))
 			}
 		}
 	}
@@ -681,7 +799,7 @@ func (c *clientWrapper) objectPut(ctx context.Context, prm PrmObjectPut) (oid.ID
 	if res != nil {
 		st = res.Status()
 	}
-	if err = c.handleError(st, err); err != nil { // here err already carries both status and client errors
+	if err = c.handleMethodError(methodObjectPut, st, err); err != nil { // here err already carries both status and client errors
 		return oid.ID{}, fmt.Errorf("client failure: %w", err)
 	}
 
@@ -718,7 +836,7 @@ func (c *clientWrapper) objectDelete(ctx context.Context, prm PrmObjectDelete) e
 	if res != nil {
 		st = res.Status()
 	}
-	if err = c.handleError(st, err); err != nil {
+	if err = c.handleMethodError(methodObjectDelete, st, err); err != nil {
 		return fmt.Errorf("delete object on client: %w", err)
 	}
 	return nil
@@ -750,7 +868,7 @@ func (c *clientWrapper) objectGet(ctx context.Context, prm PrmObjectGet) (ResGet
 	var res ResGetObject
 
 	rObj, err := cl.ObjectGetInit(ctx, cliPrm)
-	if err = c.handleError(nil, err); err != nil {
+	if err = c.handleContextError(ctx, methodObjectGet, nil, err); err != nil {
 		return ResGetObject{}, fmt.Errorf("init object reading on client: %w", err)
 	}
 
@@ -763,14 +881,14 @@ func (c *clientWrapper) objectGet(ctx context.Context, prm PrmObjectGet) (ResGet
 		if rObjRes != nil {
 			st = rObjRes.Status()
 		}
-		err = c.handleError(st, err)
+		err = c.handleContextError(ctx, methodObjectGet, st, err)
 		return res, fmt.Errorf("read header: %w", err)
 	}
 
 	res.Payload = &objectReadCloser{
 		reader: rObj,
 		elapsedTimeCallback: func(elapsed time.Duration) {
-			c.incRequests(elapsed, methodObjectGet)
+			c.incStreamRequests(elapsed, methodObjectGet)
 		},
 	}
 
@@ -812,7 +930,7 @@ func (c *clientWrapper) objectHead(ctx context.Context, prm PrmObjectHead) (obje
 	if res != nil {
 		st = res.Status()
 	}
-	if err = c.handleError(st, err); err != nil {
+	if err = c.handleContextError(ctx, methodObjectHead, st, err); err != nil {
 		return obj, fmt.Errorf("read object header via client: %w", err)
 	}
 	if !res.ReadHeader(&obj) {
@@ -850,18 +968,64 @@ func (c *clientWrapper) objectRange(ctx context.Context, prm PrmObjectRange) (Re
 	start := time.Now()
 	res, err := cl.ObjectRangeInit(ctx, cliPrm)
 	c.incRequests(time.Since(start), methodObjectRange)
-	if err = c.handleError(nil, err); err != nil {
+	if err = c.handleContextError(ctx, methodObjectRange, nil, err); err != nil {
 		return ResObjectRange{}, fmt.Errorf("init payload range reading on client: %w", err)
 	}
 
 	return ResObjectRange{
 		payload: res,
 		elapsedTimeCallback: func(elapsed time.Duration) {
-			c.incRequests(elapsed, methodObjectRange)
+			c.incStreamRequests(elapsed, methodObjectRange)
 		},
 	}, nil
 }
 
+// objectHash invokes sdkClient.ObjectHash parse response status to error and return result as is.
+func (c *clientWrapper) objectHash(ctx context.Context, prm PrmObjectHash) ([][]byte, error) {
+	cl, err := c.getClient()
+	if err != nil {
+		return nil, err
+	}
+
+	var cliPrm sdkClient.PrmObjectHash
+	cliPrm.FromContainer(prm.addr.Container())
+	cliPrm.ByID(prm.addr.Object())
+	cliPrm.SetRangeList(prm.ranges...)
+
+	if prm.tz {
+		cliPrm.TillichZemorAlgo()
+	}
+
+	if prm.salt != nil {
+		cliPrm.UseSalt(prm.salt)
+	}
+
+	if prm.stoken != nil {
+		cliPrm.WithinSession(*prm.stoken)
+	}
+
+	if prm.btoken != nil {
+		cliPrm.WithBearerToken(*prm.btoken)
+	}
+
+	if prm.key != nil {
+		cliPrm.UseKey(*prm.key)
+	}
+
+	start := time.Now()
+	res, err := cl.ObjectHash(ctx, cliPrm)
+	c.incRequests(time.Since(start), methodObjectHash)
+	var st apistatus.Status
+	if res != nil {
+		st = res.Status()
+	}
+	if err = c.handleMethodError(methodObjectHash, st, err); err != nil {
+		return nil, fmt.Errorf("hash object payload ranges via client: %w", err)
+	}
+
+	return res.Checksums(), nil
+}
+
 // objectSearch invokes sdkClient.ObjectSearchInit parse response status to error and return result as is.
 func (c *clientWrapper) objectSearch(ctx context.Context, prm PrmObjectSearch) (ResObjectSearch, error) {
 	cl, err := c.getClient()
@@ -886,8 +1050,10 @@ func (c *clientWrapper) objectSearch(ctx context.Context, prm PrmObjectSearch) (
 		cliPrm.UseKey(*prm.key)
 	}
 
+	start := time.Now()
 	res, err := cl.ObjectSearchInit(ctx, cliPrm)
-	if err = c.handleError(nil, err); err != nil {
+	c.incRequests(time.Since(start), methodObjectSearch)
+	if err = c.handleMethodError(methodObjectSearch, nil, err); err != nil {
 		return ResObjectSearch{}, fmt.Errorf("init object searching on client: %w", err)
 	}
 
@@ -912,7 +1078,7 @@ func (c *clientWrapper) sessionCreate(ctx context.Context, prm prmCreateSession)
 	if res != nil {
 		st = res.Status()
 	}
-	if err = c.handleError(st, err); err != nil {
+	if err = c.handleMethodError(methodSessionCreate, st, err); err != nil {
 		return resCreateSession{}, fmt.Errorf("session creation on client: %w", err)
 	}
 
@@ -934,6 +1100,14 @@ func (c *clientStatusMonitor) setUnhealthy() {
 	c.healthy.Store(false)
 }
 
+func (c *clientStatusMonitor) isMaintenance() bool {
+	return c.maintenance.Load()
+}
+
+func (c *clientStatusMonitor) setMaintenance(maintenance bool) {
+	c.maintenance.Store(maintenance)
+}
+
 func (c *clientStatusMonitor) address() string {
 	return c.addr
 }
@@ -961,6 +1135,43 @@ func (c *clientStatusMonitor) overallErrorRate() uint64 {
 	return c.overallErrorCount
 }
 
+func (c *clientStatusMonitor) observeLatency(elapsed time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.latencyMeasured {
+		c.latencyEWMA = float64(elapsed)
+		c.latencyMeasured = true
+	} else {
+		c.latencyEWMA += latencySmoothingFactor * (float64(elapsed) - c.latencyEWMA)
+	}
+}
+
+func (c *clientStatusMonitor) seedLatency(l time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.latencyMeasured {
+		c.latencyEWMA = float64(l)
+	}
+}
+
+func (c *clientStatusMonitor) latency() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return time.Duration(c.latencyEWMA)
+}
+
+func (c *clientStatusMonitor) allowRequest(method MethodIndex) bool {
+	if cb := c.breakers.get(method); cb != nil {
+		return cb.allow()
+	}
+
+	return true
+}
+
+func (c *clientStatusMonitor) circuitState() CircuitState {
+	return c.breakers.state()
+}
+
 func (c *clientStatusMonitor) methodsStatus() []statusSnapshot {
 	result := make([]statusSnapshot, len(c.methods))
 	for i, val := range c.methods {
@@ -970,7 +1181,17 @@ func (c *clientStatusMonitor) methodsStatus() []statusSnapshot {
 	return result
 }
 
+// incRequests accounts the request of the given method. Request time is taken
+// into account in the node latency.
 func (c *clientWrapper) incRequests(elapsed time.Duration, method MethodIndex) {
+	c.observeLatency(elapsed)
+	c.incStreamRequests(elapsed, method)
+}
+
+// incStreamRequests accounts the payload part of the stream request of the
+// given method. Time of such parts depends on the payload size rather than on
+// the node, so it is not taken into account in the node latency.
+func (c *clientWrapper) incStreamRequests(elapsed time.Duration, method MethodIndex) {
 	methodStat := c.methods[method]
 	methodStat.incRequests(elapsed)
 	if c.prm.poolRequestInfoCallback != nil {
@@ -983,27 +1204,63 @@ func (c *clientWrapper) incRequests(elapsed time.Duration, method MethodIndex) {
 }
 
 func (c *clientStatusMonitor) handleError(st apistatus.Status, err error) error {
+	return c.handleMethodError(methodLast, st, err)
+}
+
+// handleContextError is handleMethodError for the request executed within the
+// given context. Requests aborted by the context cancellation (e.g. losers of
+// the hedged reads) are not node failures, so their errors are returned as is.
+func (c *clientStatusMonitor) handleContextError(ctx context.Context, method MethodIndex, st apistatus.Status, err error) error {
+	if err != nil && errors.Is(ctx.Err(), context.Canceled) {
+		return err
+	}
+
+	return c.handleMethodError(method, st, err)
+}
+
+// handleMethodError is handleError for the request of the given method. Result
+// of the request is also counted by the circuit breaker of the method.
+func (c *clientStatusMonitor) handleMethodError(method MethodIndex, st apistatus.Status, err error) error {
+	cb := c.breakers.get(method)
+
 	if err != nil {
 		// non-status logic error that could be returned
 		// from the SDK client; should not be considered
 		// as a connection error
 		var siErr *object.SplitInfoError
-		if !errors.As(err, &siErr) {
+		failed := !errors.As(err, &siErr)
+		if failed {
 			c.incErrorRate()
 		}
 
+		if cb != nil {
+			cb.record(failed)
+		}
+
 		return err
 	}
 
+	// status errors are returned as is, so callers can check them using
+	// apistatus sentinels and classes
 	err = apistatus.ErrFromStatus(st)
-	switch err.(type) {
-	case apistatus.ServerInternal, *apistatus.ServerInternal,
-		apistatus.WrongMagicNumber, *apistatus.WrongMagicNumber,
-		apistatus.SignatureVerification, *apistatus.SignatureVerification,
-		apistatus.NodeUnderMaintenance, *apistatus.NodeUnderMaintenance:
+	if errors.Is(err, apistatus.ErrNodeUnderMaintenance) {
+		// maintenance is planned and is not a node failure, so node is
+		// drained until it is re-probed instead of counting errors
+		c.setMaintenance(true)
+		return err
+	}
+
+	failed := errors.Is(err, apistatus.ErrServerInternal) ||
+		errors.Is(err, apistatus.ErrWrongMagicNumber) ||
+		errors.Is(err, apistatus.ErrSignatureVerification)
+	if failed {
 		c.incErrorRate()
 	}
 
+	if cb != nil {
+		cb.record(failed)
+	}
+
 	return err
 }
 
@@ -1026,10 +1283,17 @@ type InitParameters struct {
 	nodeStreamTimeout         time.Duration
 	healthcheckTimeout        time.Duration
 	clientRebalanceInterval   time.Duration
+	maintenanceProbeInterval  time.Duration
 	sessionExpirationDuration uint64
 	errorThreshold            uint32
 	nodeParams                []NodeParam
 	requestCallback           func(RequestInfo)
+	exporter                  telemetry.Exporter
+	discovery                 DiscoveryParams
+	adaptiveWeights           bool
+	powerOfTwoChoices         bool
+	hedging                   HedgingParams
+	circuitBreaker            CircuitBreakerParams
 
 	clientBuilder clientBuilder
 }
@@ -1068,6 +1332,15 @@ func (x *InitParameters) SetClientRebalanceInterval(interval time.Duration) {
 	x.clientRebalanceInterval = interval
 }
 
+// SetMaintenanceProbeInterval specifies the interval for re-probing the nodes
+// under maintenance. Such nodes are excluded from the request routing until
+// they report that maintenance is over.
+//
+// See also Pool.Dial.
+func (x *InitParameters) SetMaintenanceProbeInterval(interval time.Duration) {
+	x.maintenanceProbeInterval = interval
+}
+
 // SetSessionExpirationDuration specifies the session token lifetime in epochs.
 func (x *InitParameters) SetSessionExpirationDuration(expirationDuration uint64) {
 	x.sessionExpirationDuration = expirationDuration
@@ -1084,6 +1357,52 @@ func (x *InitParameters) SetRequestCallback(f func(RequestInfo)) {
 	x.requestCallback = f
 }
 
+// SetExporter makes the pool to export telemetry to e: spans of all NeoFS API
+// calls of the underlying clients, node health transitions and session cache
+// hits/misses. Nil (default) means no export.
+func (x *InitParameters) SetExporter(e telemetry.Exporter) {
+	x.exporter = e
+}
+
+// SetDiscovery enables discovery of the pool nodes from the network map.
+//
+// See also DiscoveryParams.
+func (x *InitParameters) SetDiscovery(prm DiscoveryParams) {
+	x.discovery = prm
+}
+
+// EnableAdaptiveWeights makes the pool to balance the requests within the
+// priority group according to the node latency and error rate. Effective
+// weight of the node is recalculated on each rebalance: it is reduced in
+// proportion to the node latency relative to the fastest node of the group
+// and to the number of errors relative to the error threshold. Weight set
+// in NodeParam is never exceeded.
+//
+// See also NodeStatistic.EffectiveWeight, SetClientRebalanceInterval.
+func (x *InitParameters) EnableAdaptiveWeights() {
+	x.adaptiveWeights = true
+}
+
+// EnablePowerOfTwoChoices makes the pool to sample two nodes of the priority
+// group for each request and to select the one with the lower latency.
+func (x *InitParameters) EnablePowerOfTwoChoices() {
+	x.powerOfTwoChoices = true
+}
+
+// SetHedging enables hedged reads.
+//
+// See also HedgingParams.
+func (x *InitParameters) SetHedging(prm HedgingParams) {
+	x.hedging = prm
+}
+
+// SetCircuitBreaker enables circuit breakers of the pool nodes.
+//
+// See also CircuitBreakerParams.
+func (x *InitParameters) SetCircuitBreaker(prm CircuitBreakerParams) {
+	x.circuitBreaker = prm
+}
+
 // AddNode append information about the node to which you want to connect.
 func (x *InitParameters) AddNode(nodeParam NodeParam) {
 	x.nodeParams = append(x.nodeParams, nodeParam)
@@ -1104,7 +1423,10 @@ type rebalanceParameters struct {
 	nodesParams               []*nodesParam
 	nodeRequestTimeout        time.Duration
 	clientRebalanceInterval   time.Duration
+	maintenanceProbeInterval  time.Duration
 	sessionExpirationDuration uint64
+	errorThreshold            uint32
+	adaptiveWeights           bool
 }
 
 type nodesParam struct {
@@ -1179,6 +1501,9 @@ type prmContext struct {
 	verb           session.ObjectVerb
 	cnr            cid.ID
 
+	methodSet bool
+	method    MethodIndex
+
 	objSet bool
 	objs   []oid.ID
 }
@@ -1206,6 +1531,20 @@ func (x *prmContext) useVerb(verb session.ObjectVerb) {
 	x.verb = verb
 }
 
+func (x *prmContext) useMethod(method MethodIndex) {
+	x.method = method
+	x.methodSet = true
+}
+
+// requestMethod returns method of the request used for the node selection.
+func (x prmContext) requestMethod() MethodIndex {
+	if x.methodSet {
+		return x.method
+	}
+
+	return methodLast
+}
+
 type prmCommon struct {
 	key    *ecdsa.PrivateKey
 	btoken *bearer.Token
@@ -1320,6 +1659,47 @@ func (x *PrmObjectRange) SetLength(length uint64) {
 	x.ln = length
 }
 
+// PrmObjectHash groups parameters of ObjectHash operation.
+type PrmObjectHash struct {
+	prmCommon
+
+	addr oid.Address
+
+	ranges []uint64
+
+	tz bool
+
+	salt []byte
+}
+
+// SetAddress specifies NeoFS address of the object.
+func (x *PrmObjectHash) SetAddress(addr oid.Address) {
+	x.addr = addr
+}
+
+// SetRangeList sets list of ranges in (offset, length) pair format.
+// Required parameter.
+//
+// If passed as slice, then it must not be mutated before the operation completes.
+func (x *PrmObjectHash) SetRangeList(r ...uint64) {
+	x.ranges = r
+}
+
+// TillichZemorAlgo changes the hash function to Tillich-Zemor
+// (https://link.springer.com/content/pdf/10.1007/3-540-48658-5_5.pdf).
+//
+// By default, SHA256 hash function is used.
+func (x *PrmObjectHash) TillichZemorAlgo() {
+	x.tz = true
+}
+
+// UseSalt sets the salt to XOR the data range before hashing.
+//
+// Must not be mutated before the operation completes.
+func (x *PrmObjectHash) UseSalt(salt []byte) {
+	x.salt = salt
+}
+
 // PrmObjectSearch groups parameters of SearchObjects operation.
 type PrmObjectSearch struct {
 	prmCommon
@@ -1502,6 +1882,9 @@ type prmEndpointInfo struct{}
 // prmNetworkInfo groups parameters of networkInfo operation.
 type prmNetworkInfo struct{}
 
+// prmNetMapSnapshot groups parameters of netMapSnapshot operation.
+type prmNetMapSnapshot struct{}
+
 // resCreateSession groups resulting values of sessionCreate operation.
 type resCreateSession struct {
 	id []byte
@@ -1531,7 +1914,11 @@ type resCreateSession struct {
 //
 // See pool package overview to get some examples.
 type Pool struct {
-	innerPools      []*innerPool
+	membersLock sync.RWMutex // protects innerPools, rebalanceParams.nodesParams, nodeParams and discovered
+	innerPools  []*innerPool
+	nodeParams  []NodeParam
+	discovered  map[string]struct{}
+
 	key             *ecdsa.PrivateKey
 	cancel          context.CancelFunc
 	closedCh        chan struct{}
@@ -1540,22 +1927,37 @@ type Pool struct {
 	rebalanceParams rebalanceParameters
 	clientBuilder   clientBuilder
 	logger          *zap.Logger
+	exporter        telemetry.Exporter
+	discovery       DiscoveryParams
+	powerOfTwo      bool
+	hedging         HedgingParams
+
+	hedgeableReads atomic.Uint64
+	hedges         atomic.Uint64
+	hedgeWins      atomic.Uint64
+
+	ops operations
 }
 
 type innerPool struct {
 	lock    sync.RWMutex
 	sampler *sampler
 	clients []client
+	// weights is a normalized weights the sampler is built with
+	weights []float64
+	// powerOfTwo makes connection to select faster node of the two sampled
+	powerOfTwo bool
 }
 
 const (
 	defaultSessionTokenExpirationDuration = 100 // in blocks
 	defaultErrorThreshold                 = 100
 
-	defaultRebalanceInterval  = 25 * time.Second
-	defaultHealthcheckTimeout = 4 * time.Second
-	defaultDialTimeout        = 5 * time.Second
-	defaultStreamTimeout      = 10 * time.Second
+	defaultRebalanceInterval        = 25 * time.Second
+	defaultMaintenanceProbeInterval = 10 * time.Second
+	defaultHealthcheckTimeout       = 4 * time.Second
+	defaultDialTimeout              = 5 * time.Second
+	defaultStreamTimeout            = 10 * time.Second
 )
 
 // NewPool creates connection pool using parameters.
@@ -1577,6 +1979,8 @@ func NewPool(options InitParameters) (*Pool, error) {
 	fillDefaultInitParams(&options, cache)
 
 	pool := &Pool{
+		nodeParams:     append([]NodeParam(nil), options.nodeParams...),
+		discovered:     make(map[string]struct{}),
 		key:            options.key,
 		cache:          cache,
 		logger:         options.logger,
@@ -1585,9 +1989,16 @@ func NewPool(options InitParameters) (*Pool, error) {
 			nodesParams:               nodesParams,
 			nodeRequestTimeout:        options.healthcheckTimeout,
 			clientRebalanceInterval:   options.clientRebalanceInterval,
+			maintenanceProbeInterval:  options.maintenanceProbeInterval,
 			sessionExpirationDuration: options.sessionExpirationDuration,
+			errorThreshold:            options.errorThreshold,
+			adaptiveWeights:           options.adaptiveWeights,
 		},
 		clientBuilder: options.clientBuilder,
+		exporter:      options.exporter,
+		discovery:     options.discovery,
+		powerOfTwo:    options.powerOfTwoChoices,
+		hedging:       options.hedging,
 	}
 
 	return pool, nil
@@ -1608,35 +2019,22 @@ func (p *Pool) Dial(ctx context.Context) error {
 	for i, params := range p.rebalanceParams.nodesParams {
 		clients := make([]client, len(params.weights))
 		for j, addr := range params.addresses {
-			clients[j] = p.clientBuilder(addr)
-			if err := clients[j].dial(ctx); err != nil {
-				if p.logger != nil {
-					p.logger.Warn("failed to build client", zap.String("address", addr), zap.Error(err))
-				}
-				continue
-			}
-
-			var st session.Object
-			err := initSessionForDuration(ctx, &st, clients[j], p.rebalanceParams.sessionExpirationDuration, *p.key)
-			if err != nil {
-				clients[j].setUnhealthy()
+			var err error
+			if clients[j], err = p.dialNode(ctx, addr); err != nil {
 				if p.logger != nil {
-					p.logger.Warn("failed to create neofs session token for client",
-						zap.String("address", addr), zap.Error(err))
+					p.logger.Warn("failed to dial node", zap.String("address", addr), zap.Error(err))
 				}
 				continue
 			}
 
-			_ = p.cache.Put(formCacheKey(addr, p.key), st)
 			atLeastOneHealthy = true
 		}
-		source := rand.NewSource(time.Now().UnixNano())
-		sampl := newSampler(params.weights, source)
 
 		inner[i] = &innerPool{
-			sampler: sampl,
-			clients: clients,
+			clients:    clients,
+			powerOfTwo: p.powerOfTwo,
 		}
+		inner[i].resetSampler(params.weights)
 	}
 
 	if !atLeastOneHealthy {
@@ -1646,12 +2044,34 @@ func (p *Pool) Dial(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
 	p.cancel = cancel
 	p.closedCh = make(chan struct{})
+	p.membersLock.Lock()
 	p.innerPools = inner
+	p.membersLock.Unlock()
 
 	go p.startRebalance(ctx)
 	return nil
 }
 
+// dialNode creates client of the node, connects to it and opens the default
+// session. Returned client is never nil, it is marked unhealthy on failure.
+func (p *Pool) dialNode(ctx context.Context, addr string) (client, error) {
+	cl := p.clientBuilder(addr)
+	if err := cl.dial(ctx); err != nil {
+		return cl, fmt.Errorf("build client: %w", err)
+	}
+
+	var st session.Object
+	err := initSessionForDuration(ctx, &st, cl, p.rebalanceParams.sessionExpirationDuration, *p.key)
+	if err != nil {
+		cl.setUnhealthy()
+		return cl, fmt.Errorf("create neofs session token for client: %w", err)
+	}
+
+	_ = p.cache.Put(formCacheKey(addr, p.key), st)
+
+	return cl, nil
+}
+
 func fillDefaultInitParams(params *InitParameters, cache *sessionCache) {
 	if params.sessionExpirationDuration == 0 {
 		params.sessionExpirationDuration = defaultSessionTokenExpirationDuration
@@ -1665,6 +2085,10 @@ func fillDefaultInitParams(params *InitParameters, cache *sessionCache) {
 		params.clientRebalanceInterval = defaultRebalanceInterval
 	}
 
+	if params.maintenanceProbeInterval <= 0 {
+		params.maintenanceProbeInterval = defaultMaintenanceProbeInterval
+	}
+
 	if params.healthcheckTimeout <= 0 {
 		params.healthcheckTimeout = defaultHealthcheckTimeout
 	}
@@ -1686,6 +2110,8 @@ func fillDefaultInitParams(params *InitParameters, cache *sessionCache) {
 			prm.setStreamTimeout(params.nodeStreamTimeout)
 			prm.setErrorThreshold(params.errorThreshold)
 			prm.setPoolRequestCallback(params.requestCallback)
+			prm.setExporter(params.exporter)
+			prm.setCircuitBreaker(params.circuitBreaker)
 			prm.setResponseInfoCallback(func(info sdkClient.ResponseMetaInfo) error {
 				cache.updateEpoch(info.Epoch())
 				return nil
@@ -1724,13 +2150,29 @@ func adjustNodeParams(nodeParams []NodeParam) ([]*nodesParam, error) {
 	return nodesParams, nil
 }
 
-// startRebalance runs loop to monitor connection healthy status.
+// startRebalance runs loop to monitor connection healthy status. Nodes under
+// maintenance are additionally re-probed on a separate schedule.
 func (p *Pool) startRebalance(ctx context.Context) {
 	ticker := time.NewTimer(p.rebalanceParams.clientRebalanceInterval)
+	maintenanceTicker := time.NewTimer(p.rebalanceParams.maintenanceProbeInterval)
+
+	// nil channel blocks forever, so discovery is done only when it is enabled
+	var (
+		discoveryTicker *time.Timer
+		discoveryCh     <-chan time.Time
+	)
+	if p.discovery.interval > 0 {
+		// first discovery is done right after the dial
+		discoveryTicker = time.NewTimer(0)
+		discoveryCh = discoveryTicker.C
+	}
+
+	p.membersLock.RLock()
 	buffers := make([][]float64, len(p.rebalanceParams.nodesParams))
 	for i, params := range p.rebalanceParams.nodesParams {
 		buffers[i] = make([]float64, len(params.weights))
 	}
+	p.membersLock.RUnlock()
 
 	for {
 		select {
@@ -1740,132 +2182,325 @@ func (p *Pool) startRebalance(ctx context.Context) {
 		case <-ticker.C:
 			p.updateNodesHealth(ctx, buffers)
 			ticker.Reset(p.rebalanceParams.clientRebalanceInterval)
+		case <-maintenanceTicker.C:
+			p.probeMaintenance(ctx, buffers)
+			maintenanceTicker.Reset(p.rebalanceParams.maintenanceProbeInterval)
+		case <-discoveryCh:
+			p.discover(ctx)
+			discoveryTicker.Reset(p.discovery.interval)
 		}
 	}
 }
 
 func (p *Pool) updateNodesHealth(ctx context.Context, buffers [][]float64) {
 	wg := sync.WaitGroup{}
-	for i, inner := range p.innerPools {
+	for i := 0; i < p.innerPoolsNumber(); i++ {
 		wg.Add(1)
 
-		bufferWeights := buffers[i]
-		go func(i int, innerPool *innerPool) {
+		var bufferWeights []float64
+		if i < len(buffers) {
+			bufferWeights = buffers[i]
+		}
+		go func(i int) {
 			defer wg.Done()
 			p.updateInnerNodesHealth(ctx, i, bufferWeights)
-		}(i, inner)
+		}(i)
 	}
 	wg.Wait()
 }
 
 func (p *Pool) updateInnerNodesHealth(ctx context.Context, i int, bufferWeights []float64) {
-	if i > len(p.innerPools)-1 {
+	pool, params, ok := p.innerPoolAt(i)
+	if !ok {
 		return
 	}
-	pool := p.innerPools[i]
-	options := p.rebalanceParams
+	timeout := p.rebalanceParams.nodeRequestTimeout
+	bufferWeights = weightsBuffer(bufferWeights, len(pool.clients))
 
 	healthyChanged := atomic.NewBool(false)
 	wg := sync.WaitGroup{}
 
-	for j, cli := range pool.clients {
+	for _, cli := range pool.clients {
 		wg.Add(1)
-		go func(j int, cli client) {
+		go func(cli client) {
 			defer wg.Done()
 
-			tctx, c := context.WithTimeout(ctx, options.nodeRequestTimeout)
+			tctx, c := context.WithTimeout(ctx, timeout)
 			defer c()
 
+			wasMaintenance := cli.isMaintenance()
+
 			healthy, changed := cli.restartIfUnhealthy(tctx)
-			if healthy {
-				bufferWeights[j] = options.nodesParams[i].weights[j]
-			} else {
-				bufferWeights[j] = 0
+			if !healthy {
 				p.cache.DeleteByPrefix(cli.address())
 			}
 
+			// nodes can enter maintenance between the checks, so the sampler is
+			// rebuilt while any node of the group is under maintenance
+			if wasMaintenance || cli.isMaintenance() {
+				healthyChanged.Store(true)
+			}
+
 			if changed {
 				healthyChanged.Store(true)
+
+				if p.exporter != nil {
+					p.exporter.ObserveHealth(cli.address(), healthy)
+				}
 			}
-		}(j, cli)
+		}(cli)
 	}
 	wg.Wait()
 
-	if healthyChanged.Load() {
-		probabilities := adjustWeights(bufferWeights)
-		source := rand.NewSource(time.Now().UnixNano())
-		pool.lock.Lock()
-		pool.sampler = newSampler(probabilities, source)
-		pool.lock.Unlock()
+	// effective weights depend on the latency which changes constantly
+	if healthyChanged.Load() || p.rebalanceParams.adaptiveWeights {
+		p.effectiveWeights(bufferWeights, pool.clients, params.weights)
+		pool.resetSampler(bufferWeights)
 	}
 }
 
-func adjustWeights(weights []float64) []float64 {
-	adjusted := make([]float64, len(weights))
-	sum := 0.0
-	for _, weight := range weights {
-		sum += weight
-	}
-	if sum > 0 {
-		for i, weight := range weights {
-			adjusted[i] = weight / sum
+// probeMaintenance re-probes the nodes under maintenance and returns the ones
+// which finished maintenance back to the request routing.
+func (p *Pool) probeMaintenance(ctx context.Context, buffers [][]float64) {
+	wg := sync.WaitGroup{}
+	for i := 0; i < p.innerPoolsNumber(); i++ {
+		wg.Add(1)
+
+		var bufferWeights []float64
+		if i < len(buffers) {
+			bufferWeights = buffers[i]
 		}
+		go func(i int) {
+			defer wg.Done()
+			p.probeInnerMaintenance(ctx, i, bufferWeights)
+		}(i)
 	}
-
-	return adjusted
+	wg.Wait()
 }
 
-func (p *Pool) connection() (client, error) {
-	for _, inner := range p.innerPools {
-		cp, err := inner.connection()
-		if err == nil {
-			return cp, nil
-		}
+func (p *Pool) probeInnerMaintenance(ctx context.Context, i int, bufferWeights []float64) {
+	pool, params, ok := p.innerPoolAt(i)
+	if !ok {
+		return
 	}
+	timeout := p.rebalanceParams.nodeRequestTimeout
+	bufferWeights = weightsBuffer(bufferWeights, len(pool.clients))
 
-	return nil, errors.New("no healthy client")
-}
+	var maintenance bool
+	wg := sync.WaitGroup{}
 
-func (p *innerPool) connection() (client, error) {
-	p.lock.RLock() // need lock because of using p.sampler
-	defer p.lock.RUnlock()
-	if len(p.clients) == 1 {
-		cp := p.clients[0]
-		if cp.isHealthy() {
-			return cp, nil
-		}
-		return nil, errors.New("no healthy client")
-	}
-	attempts := 3 * len(p.clients)
-	for k := 0; k < attempts; k++ {
-		i := p.sampler.Next()
-		if cp := p.clients[i]; cp.isHealthy() {
-			return cp, nil
+	for _, cli := range pool.clients {
+		if !cli.isMaintenance() {
+			continue
 		}
-	}
 
-	return nil, errors.New("no healthy client")
-}
+		maintenance = true
 
-func formCacheKey(address string, key *ecdsa.PrivateKey) string {
-	k := keys.PrivateKey{PrivateKey: *key}
-	return address + k.String()
-}
+		wg.Add(1)
+		go func(cli client) {
+			defer wg.Done()
 
-func (p *Pool) checkSessionTokenErr(err error, address string) bool {
-	if err == nil {
-		return false
+			tctx, c := context.WithTimeout(ctx, timeout)
+			defer c()
+
+			// endpoint info updates maintenance status of the node
+			_, _ = cli.endpointInfo(tctx, prmEndpointInfo{})
+		}(cli)
 	}
+	wg.Wait()
 
-	if sdkClient.IsErrSessionNotFound(err) || sdkClient.IsErrSessionExpired(err) {
-		p.cache.DeleteByPrefix(address)
-		return true
+	if !maintenance {
+		return
 	}
 
-	return false
+	p.effectiveWeights(bufferWeights, pool.clients, params.weights)
+	pool.resetSampler(bufferWeights)
 }
 
-func initSessionForDuration(ctx context.Context, dst *session.Object, c client, dur uint64, ownerKey ecdsa.PrivateKey) error {
+// effectiveWeights writes weights of the clients to be used for the request
+// balancing to dst. Unavailable clients have zero weight. In adaptive mode
+// the weights are reduced according to the client latency and error rate.
+func (p *Pool) effectiveWeights(dst []float64, clients []client, weights []float64) {
+	var minLatency time.Duration
+
+	if p.rebalanceParams.adaptiveWeights {
+		for _, cli := range clients {
+			if l := cli.latency(); isAvailable(cli) && l > 0 && (minLatency == 0 || l < minLatency) {
+				minLatency = l
+			}
+		}
+	}
+
+	for j, cli := range clients {
+		if !isAvailable(cli) {
+			dst[j] = 0
+			continue
+		}
+
+		dst[j] = weights[j]
+
+		if !p.rebalanceParams.adaptiveWeights {
+			continue
+		}
+
+		// nodes without requests keep the weight in order to be measured
+		if l := cli.latency(); l > 0 {
+			dst[j] *= float64(minLatency) / float64(l)
+		}
+
+		if threshold := p.rebalanceParams.errorThreshold; threshold > 0 {
+			if errs := cli.currentErrorRate(); errs < threshold {
+				dst[j] *= 1 - float64(errs)/float64(threshold)
+			}
+		}
+	}
+}
+
+// resetSampler replaces sampler of the inner pool with the new one built using
+// given weights.
+func (p *innerPool) resetSampler(weights []float64) {
+	probabilities := adjustWeights(weights)
+	source := rand.NewSource(time.Now().UnixNano())
+	p.lock.Lock()
+	p.sampler = newSampler(probabilities, source)
+	p.weights = probabilities
+	p.lock.Unlock()
+}
+
+// innerPoolsNumber returns current number of the inner pools.
+func (p *Pool) innerPoolsNumber() int {
+	p.membersLock.RLock()
+	defer p.membersLock.RUnlock()
+	return len(p.innerPools)
+}
+
+// innerPoolAt returns i-th inner pool along with its parameters. Returns false
+// if there is no such pool, e.g. if the pool membership has been changed.
+func (p *Pool) innerPoolAt(i int) (*innerPool, *nodesParam, bool) {
+	p.membersLock.RLock()
+	defer p.membersLock.RUnlock()
+
+	if i > len(p.innerPools)-1 {
+		return nil, nil, false
+	}
+
+	return p.innerPools[i], p.rebalanceParams.nodesParams[i], true
+}
+
+// weightsBuffer returns buffer for n weights reusing buf if it fits.
+func weightsBuffer(buf []float64, n int) []float64 {
+	if len(buf) < n {
+		return make([]float64, n)
+	}
+
+	return buf[:n]
+}
+
+func adjustWeights(weights []float64) []float64 {
+	adjusted := make([]float64, len(weights))
+	sum := 0.0
+	for _, weight := range weights {
+		sum += weight
+	}
+	if sum > 0 {
+		for i, weight := range weights {
+			adjusted[i] = weight / sum
+		}
+	}
+
+	return adjusted
+}
+
+func (p *Pool) connection() (client, error) {
+	return p.connectionFor(methodLast)
+}
+
+// connectionFor returns connection to the node which can serve the request of
+// the given method. methodLast means request of unknown method.
+func (p *Pool) connectionFor(method MethodIndex) (client, error) {
+	p.membersLock.RLock()
+	innerPools := p.innerPools
+	p.membersLock.RUnlock()
+
+	for _, inner := range innerPools {
+		cp, err := inner.connectionFor(method)
+		if err == nil {
+			return cp, nil
+		}
+	}
+
+	return nil, errors.New("no healthy client")
+}
+
+func (p *innerPool) connection() (client, error) {
+	return p.connectionFor(methodLast)
+}
+
+// connectionFor returns connection to the node of the group which can serve
+// the request of the given method. Note that circuit breaker of the returned
+// node accounts the request, so the node is checked after the selection.
+func (p *innerPool) connectionFor(method MethodIndex) (client, error) {
+	p.lock.RLock() // need lock because of using p.sampler
+	defer p.lock.RUnlock()
+	if len(p.clients) == 1 {
+		cp := p.clients[0]
+		if isAvailable(cp) && cp.allowRequest(method) {
+			return cp, nil
+		}
+		return nil, errors.New("no healthy client")
+	}
+	attempts := 3 * len(p.clients)
+	for k := 0; k < attempts; k++ {
+		i := p.sampler.Next()
+		if cp := p.clients[i]; isAvailable(cp) {
+			if p.powerOfTwo {
+				if other := p.clients[p.sampler.Next()]; isAvailable(other) && other.latency() < cp.latency() && other.allowRequest(method) {
+					return other, nil
+				}
+			}
+
+			if cp.allowRequest(method) {
+				return cp, nil
+			}
+		}
+	}
+
+	// nodes rejected by the circuit breakers are not excluded from the sampler,
+	// so they can take all the attempts
+	for _, cp := range p.clients {
+		if isAvailable(cp) && cp.allowRequest(method) {
+			return cp, nil
+		}
+	}
+
+	return nil, errors.New("no healthy client")
+}
+
+// isAvailable checks if the client can handle requests: it is healthy and
+// the node is not under maintenance.
+func isAvailable(c clientStatus) bool {
+	return c.isHealthy() && !c.isMaintenance()
+}
+
+func formCacheKey(address string, key *ecdsa.PrivateKey) string {
+	k := keys.PrivateKey{PrivateKey: *key}
+	return address + k.String()
+}
+
+func (p *Pool) checkSessionTokenErr(err error, address string) bool {
+	if err == nil {
+		return false
+	}
+
+	if sdkClient.IsErrSessionNotFound(err) || sdkClient.IsErrSessionExpired(err) {
+		p.cache.DeleteByPrefix(address)
+		return true
+	}
+
+	return false
+}
+
+func initSessionForDuration(ctx context.Context, dst *session.Object, c client, dur uint64, ownerKey ecdsa.PrivateKey) error {
 	ni, err := c.networkInfo(ctx, prmNetworkInfo{})
 	if err != nil {
 		return err
@@ -1931,11 +2566,18 @@ type callContext struct {
 }
 
 func (p *Pool) initCallContext(ctx *callContext, cfg prmCommon, prmCtx prmContext) error {
-	cp, err := p.connection()
+	cp, err := p.connectionFor(prmCtx.requestMethod())
 	if err != nil {
 		return err
 	}
 
+	p.initCallContextFor(ctx, cp, cfg, prmCtx)
+
+	return nil
+}
+
+// initCallContextFor initializes callContext to call the given client.
+func (p *Pool) initCallContextFor(ctx *callContext, cp client, cfg prmCommon, prmCtx prmContext) {
 	ctx.key = cfg.key
 	if ctx.key == nil {
 		// use pool key if caller didn't specify its own
@@ -1957,8 +2599,6 @@ func (p *Pool) initCallContext(ctx *callContext, cfg prmCommon, prmCtx prmContex
 		ctx.sessionObjSet = prmCtx.objSet
 		ctx.sessionObjs = prmCtx.objs
 	}
-
-	return err
 }
 
 // opens new session or uses cached one.
@@ -1967,6 +2607,10 @@ func (p *Pool) openDefaultSession(ctx *callContext) error {
 	cacheKey := formCacheKey(ctx.endpoint, ctx.key)
 
 	tok, ok := p.cache.Get(cacheKey)
+	if p.exporter != nil {
+		p.exporter.ObserveSessionCache(ctx.endpoint, ok)
+	}
+
 	if !ok {
 		// init new session
 		err := initSessionForDuration(ctx, &tok, ctx.client, p.stokenDuration, *ctx.key)
@@ -2024,11 +2668,23 @@ func (p *Pool) fillAppropriateKey(prm *prmCommon) {
 //
 // Main return value MUST NOT be processed on an erroneous return.
 func (p *Pool) PutObject(ctx context.Context, prm PrmObjectPut) (oid.ID, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return oid.ID{}, err
+	}
+	defer done()
+
+	return p.putObject(ctx, prm)
+}
+
+// putObject is PutObject without the operation tracking.
+func (p *Pool) putObject(ctx context.Context, prm PrmObjectPut) (oid.ID, error) {
 	cnr, _ := prm.hdr.ContainerID()
 
 	var prmCtx prmContext
 	prmCtx.useDefaultSession()
 	prmCtx.useVerb(session.VerbObjectPut)
+	prmCtx.useMethod(methodObjectPut)
 	prmCtx.useContainer(cnr)
 
 	p.fillAppropriateKey(&prm.prmCommon)
@@ -2063,16 +2719,23 @@ func (p *Pool) PutObject(ctx context.Context, prm PrmObjectPut) (oid.ID, error)
 // It confirms the user's intent to delete the object, and is itself a container object.
 // Explicit deletion is done asynchronously, and is generally not guaranteed.
 func (p *Pool) DeleteObject(ctx context.Context, prm PrmObjectDelete) error {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
+
 	var prmCtx prmContext
 	prmCtx.useDefaultSession()
 	prmCtx.useVerb(session.VerbObjectDelete)
+	prmCtx.useMethod(methodObjectDelete)
 	prmCtx.useAddress(prm.addr)
 
 	if prm.stoken == nil { // collect phy objects only if we are about to open default session
 		var tokens relations.Tokens
 		tokens.Bearer = prm.btoken
 
-		relatives, err := relations.ListAllRelations(ctx, p, prm.addr.Container(), prm.addr.Object(), tokens)
+		relatives, err := relations.ListAllRelations(ctx, poolRelations{p}, prm.addr.Container(), prm.addr.Object(), tokens)
 		if err != nil {
 			return fmt.Errorf("failed to collect relatives: %w", err)
 		}
@@ -2090,7 +2753,7 @@ func (p *Pool) DeleteObject(ctx context.Context, prm PrmObjectDelete) error {
 	cc.Context = ctx
 	cc.sessionTarget = prm.UseSession
 
-	err := p.initCallContext(&cc, prm.prmCommon, prmCtx)
+	err = p.initCallContext(&cc, prm.prmCommon, prmCtx)
 	if err != nil {
 		return err
 	}
@@ -2134,13 +2797,36 @@ type ResGetObject struct {
 //
 // Main return value MUST NOT be processed on an erroneous return.
 func (p *Pool) GetObject(ctx context.Context, prm PrmObjectGet) (ResGetObject, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return ResGetObject{}, err
+	}
+
+	res, err := p.getObject(ctx, prm)
+	if err != nil || res.Payload == nil {
+		done()
+		return res, err
+	}
+
+	res.Payload = &operationReadCloser{ReadCloser: res.Payload, done: done}
+
+	return res, nil
+}
+
+// getObject is GetObject without the operation tracking.
+func (p *Pool) getObject(ctx context.Context, prm PrmObjectGet) (ResGetObject, error) {
 	var prmCtx prmContext
 	prmCtx.useDefaultSession()
 	prmCtx.useVerb(session.VerbObjectGet)
+	prmCtx.useMethod(methodObjectGet)
 	prmCtx.useAddress(prm.addr)
 
 	p.fillAppropriateKey(&prm.prmCommon)
 
+	if p.hedging.enabled() {
+		return p.getObjectHedged(ctx, prm, prmCtx)
+	}
+
 	var cc callContext
 	cc.Context = ctx
 	cc.sessionTarget = prm.UseSession
@@ -2158,17 +2844,70 @@ func (p *Pool) GetObject(ctx context.Context, prm PrmObjectGet) (ResGetObject, e
 	})
 }
 
+// getObjectHedged is GetObject with hedging.
+func (p *Pool) getObjectHedged(ctx context.Context, prm PrmObjectGet, prmCtx prmContext) (ResGetObject, error) {
+	var results [2]ResGetObject
+
+	i, cancel, err := p.hedgedRead(ctx, methodObjectGet, func(ctx context.Context, i int, cp client) error {
+		prm := prm
+
+		var cc callContext
+		cc.Context = ctx
+		cc.sessionTarget = prm.UseSession
+
+		p.initCallContextFor(&cc, cp, prm.prmCommon, prmCtx)
+
+		return p.call(&cc, func() error {
+			var err error
+			results[i], err = cp.objectGet(ctx, prm)
+			return err
+		})
+	}, func(i int) {
+		if results[i].Payload != nil {
+			_ = results[i].Payload.Close()
+		}
+	})
+	if err != nil {
+		return ResGetObject{}, err
+	}
+
+	res := results[i]
+	if res.Payload != nil {
+		res.Payload = &hedgedReadCloser{ReadCloser: res.Payload, cancel: cancel}
+	} else {
+		cancel()
+	}
+
+	return res, nil
+}
+
 // HeadObject reads object header through a remote server using NeoFS API protocol.
 //
 // Main return value MUST NOT be processed on an erroneous return.
 func (p *Pool) HeadObject(ctx context.Context, prm PrmObjectHead) (object.Object, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return object.Object{}, err
+	}
+	defer done()
+
+	return p.headObject(ctx, prm)
+}
+
+// headObject is HeadObject without the operation tracking.
+func (p *Pool) headObject(ctx context.Context, prm PrmObjectHead) (object.Object, error) {
 	var prmCtx prmContext
 	prmCtx.useDefaultSession()
 	prmCtx.useVerb(session.VerbObjectHead)
+	prmCtx.useMethod(methodObjectHead)
 	prmCtx.useAddress(prm.addr)
 
 	p.fillAppropriateKey(&prm.prmCommon)
 
+	if p.hedging.enabled() {
+		return p.headObjectHedged(ctx, prm, prmCtx)
+	}
+
 	var cc callContext
 
 	cc.Context = ctx
@@ -2187,6 +2926,34 @@ func (p *Pool) HeadObject(ctx context.Context, prm PrmObjectHead) (object.Object
 	})
 }
 
+// headObjectHedged is HeadObject with hedging.
+func (p *Pool) headObjectHedged(ctx context.Context, prm PrmObjectHead, prmCtx prmContext) (object.Object, error) {
+	var results [2]object.Object
+
+	i, cancel, err := p.hedgedRead(ctx, methodObjectHead, func(ctx context.Context, i int, cp client) error {
+		prm := prm
+
+		var cc callContext
+		cc.Context = ctx
+		cc.sessionTarget = prm.UseSession
+
+		p.initCallContextFor(&cc, cp, prm.prmCommon, prmCtx)
+
+		return p.call(&cc, func() error {
+			var err error
+			results[i], err = cp.objectHead(ctx, prm)
+			return err
+		})
+	}, func(int) {})
+	if err != nil {
+		return object.Object{}, err
+	}
+
+	cancel()
+
+	return results[i], nil
+}
+
 // ResObjectRange is designed to read payload range of one object
 // from NeoFS system.
 //
@@ -2195,10 +2962,27 @@ func (p *Pool) HeadObject(ctx context.Context, prm PrmObjectHead) (object.Object
 type ResObjectRange struct {
 	payload             *sdkClient.ObjectRangeReader
 	elapsedTimeCallback func(time.Duration)
+
+	// first payload bytes read in advance along with the read error
+	head    []byte
+	headErr error
+
+	// releases context of the hedged read
+	cancel context.CancelFunc
+	// completes the Pool operation
+	done func()
 }
 
 // Read implements io.Reader of the object payload.
 func (x *ResObjectRange) Read(p []byte) (int, error) {
+	if len(x.head) > 0 {
+		n := copy(p, x.head)
+		x.head = x.head[n:]
+		return n, nil
+	} else if x.headErr != nil {
+		return 0, x.headErr
+	}
+
 	start := time.Now()
 	n, err := x.payload.Read(p)
 	x.elapsedTimeCallback(time.Since(start))
@@ -2208,22 +2992,72 @@ func (x *ResObjectRange) Read(p []byte) (int, error) {
 // Close ends reading the payload range and returns the result of the operation
 // along with the final results. Must be called after using the ResObjectRange.
 func (x *ResObjectRange) Close() error {
+	if x.done != nil {
+		defer x.done()
+	}
+
+	if x.cancel != nil {
+		defer x.cancel()
+	}
+
 	_, err := x.payload.Close()
 	return err
 }
 
+// readHead waits for the first response by reading the first payload byte in
+// advance. The byte is returned by the next Read.
+func (x *ResObjectRange) readHead() error {
+	if x.payload == nil {
+		return nil
+	}
+
+	b := make([]byte, 1)
+
+	n, err := x.Read(b)
+	x.head, x.headErr = b[:n], err
+
+	if n == 0 && err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // ObjectRange initiates reading an object's payload range through a remote
 // server using NeoFS API protocol.
 //
 // Main return value MUST NOT be processed on an erroneous return.
 func (p *Pool) ObjectRange(ctx context.Context, prm PrmObjectRange) (ResObjectRange, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return ResObjectRange{}, err
+	}
+
+	res, err := p.objectRange(ctx, prm)
+	if err != nil || res.payload == nil {
+		done()
+		return res, err
+	}
+
+	res.done = done
+
+	return res, nil
+}
+
+// objectRange is ObjectRange without the operation tracking.
+func (p *Pool) objectRange(ctx context.Context, prm PrmObjectRange) (ResObjectRange, error) {
 	var prmCtx prmContext
 	prmCtx.useDefaultSession()
 	prmCtx.useVerb(session.VerbObjectRange)
+	prmCtx.useMethod(methodObjectRange)
 	prmCtx.useAddress(prm.addr)
 
 	p.fillAppropriateKey(&prm.prmCommon)
 
+	if p.hedging.enabled() {
+		return p.objectRangeHedged(ctx, prm, prmCtx)
+	}
+
 	var cc callContext
 	cc.Context = ctx
 	cc.sessionTarget = prm.UseSession
@@ -2241,17 +3075,120 @@ func (p *Pool) ObjectRange(ctx context.Context, prm PrmObjectRange) (ResObjectRa
 	})
 }
 
+// objectRangeHedged is ObjectRange with hedging. Since the payload range
+// stream is opened without waiting for the response, the first payload byte
+// is read in order to detect the first response.
+func (p *Pool) objectRangeHedged(ctx context.Context, prm PrmObjectRange, prmCtx prmContext) (ResObjectRange, error) {
+	var results [2]ResObjectRange
+
+	i, cancel, err := p.hedgedRead(ctx, methodObjectRange, func(ctx context.Context, i int, cp client) error {
+		prm := prm
+
+		var cc callContext
+		cc.Context = ctx
+		cc.sessionTarget = prm.UseSession
+
+		p.initCallContextFor(&cc, cp, prm.prmCommon, prmCtx)
+
+		return p.call(&cc, func() error {
+			res, err := cp.objectRange(ctx, prm)
+			if err != nil {
+				return err
+			}
+
+			if err = res.readHead(); err != nil {
+				if res.payload != nil {
+					_, _ = res.payload.Close()
+				}
+				return err
+			}
+
+			results[i] = res
+
+			return nil
+		})
+	}, func(i int) {
+		if results[i].payload != nil {
+			_ = results[i].Close()
+		}
+	})
+	if err != nil {
+		return ResObjectRange{}, err
+	}
+
+	res := results[i]
+	res.cancel = cancel
+
+	return res, nil
+}
+
+// ObjectHash requests checksums of the object payload ranges through a remote
+// server using NeoFS API protocol. Checksums are returned in the order of the
+// requested ranges.
+//
+// Main return value MUST NOT be processed on an erroneous return.
+func (p *Pool) ObjectHash(ctx context.Context, prm PrmObjectHash) ([][]byte, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	if len(prm.ranges) == 0 || len(prm.ranges)%2 != 0 {
+		return nil, errors.New("invalid range list")
+	}
+
+	var prmCtx prmContext
+	prmCtx.useDefaultSession()
+	prmCtx.useVerb(session.VerbObjectRangeHash)
+	prmCtx.useMethod(methodObjectHash)
+	prmCtx.useAddress(prm.addr)
+
+	p.fillAppropriateKey(&prm.prmCommon)
+
+	var cc callContext
+	cc.Context = ctx
+	cc.sessionTarget = prm.UseSession
+
+	var res [][]byte
+
+	err = p.initCallContext(&cc, prm.prmCommon, prmCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.call(&cc, func() error {
+		res, err = cc.client.objectHash(ctx, prm)
+		return err
+	})
+
+	return res, err
+}
+
+// objectListReader reads list of the found object identifiers. Implemented by
+// sdkClient.ObjectListReader.
+type objectListReader interface {
+	Read([]oid.ID) (int, bool)
+	Iterate(func(oid.ID) bool) error
+	Close() (*sdkClient.ResObjectSearch, error)
+}
+
 // ResObjectSearch is designed to read list of object identifiers from NeoFS system.
 //
 // Must be initialized using Pool.SearchObjects, any other usage is unsafe.
 type ResObjectSearch struct {
-	r *sdkClient.ObjectListReader
+	r objectListReader
+
+	// completes the Pool operation
+	done func()
 }
 
 // Read reads another list of the object identifiers.
 func (x *ResObjectSearch) Read(buf []oid.ID) (int, error) {
 	n, ok := x.r.Read(buf)
 	if !ok {
+		x.complete()
+
 		_, err := x.r.Close()
 		if err == nil {
 			return n, io.EOF
@@ -2268,15 +3205,34 @@ func (x *ResObjectSearch) Read(buf []oid.ID) (int, error) {
 //
 // Returns an error if object can't be read.
 func (x *ResObjectSearch) Iterate(f func(oid.ID) bool) error {
-	return x.r.Iterate(f)
+	var stopped bool
+
+	err := x.r.Iterate(func(id oid.ID) bool {
+		stopped = f(id)
+		return stopped
+	})
+	if !stopped {
+		// list is read till the end
+		x.complete()
+	}
+
+	return err
 }
 
 // Close ends reading list of the matched objects and returns the result of the operation
 // along with the final results. Must be called after using the ResObjectSearch.
 func (x *ResObjectSearch) Close() {
+	defer x.complete()
 	_, _ = x.r.Close()
 }
 
+// complete completes the Pool operation of the search.
+func (x *ResObjectSearch) complete() {
+	if x.done != nil {
+		x.done()
+	}
+}
+
 // SearchObjects initiates object selection through a remote server using NeoFS API protocol.
 //
 // The call only opens the transmission channel, explicit fetching of matched objects
@@ -2284,9 +3240,28 @@ func (x *ResObjectSearch) Close() {
 //
 // Main return value MUST NOT be processed on an erroneous return.
 func (p *Pool) SearchObjects(ctx context.Context, prm PrmObjectSearch) (ResObjectSearch, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return ResObjectSearch{}, err
+	}
+
+	res, err := p.searchObjects(ctx, prm)
+	if err != nil || res.r == nil {
+		done()
+		return res, err
+	}
+
+	res.done = done
+
+	return res, nil
+}
+
+// searchObjects is SearchObjects without the operation tracking.
+func (p *Pool) searchObjects(ctx context.Context, prm PrmObjectSearch) (ResObjectSearch, error) {
 	var prmCtx prmContext
 	prmCtx.useDefaultSession()
 	prmCtx.useVerb(session.VerbObjectSearch)
+	prmCtx.useMethod(methodObjectSearch)
 	prmCtx.useContainer(prm.cnrID)
 
 	p.fillAppropriateKey(&prm.prmCommon)
@@ -2320,7 +3295,13 @@ func (p *Pool) SearchObjects(ctx context.Context, prm PrmObjectSearch) (ResObjec
 //
 // Main return value MUST NOT be processed on an erroneous return.
 func (p *Pool) PutContainer(ctx context.Context, prm PrmContainerPut) (cid.ID, error) {
-	cp, err := p.connection()
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return cid.ID{}, err
+	}
+	defer done()
+
+	cp, err := p.connectionFor(methodContainerPut)
 	if err != nil {
 		return cid.ID{}, err
 	}
@@ -2332,7 +3313,13 @@ func (p *Pool) PutContainer(ctx context.Context, prm PrmContainerPut) (cid.ID, e
 //
 // Main return value MUST NOT be processed on an erroneous return.
 func (p *Pool) GetContainer(ctx context.Context, prm PrmContainerGet) (container.Container, error) {
-	cp, err := p.connection()
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return container.Container{}, err
+	}
+	defer done()
+
+	cp, err := p.connectionFor(methodContainerGet)
 	if err != nil {
 		return container.Container{}, err
 	}
@@ -2342,7 +3329,13 @@ func (p *Pool) GetContainer(ctx context.Context, prm PrmContainerGet) (container
 
 // ListContainers requests identifiers of the account-owned containers.
 func (p *Pool) ListContainers(ctx context.Context, prm PrmContainerList) ([]cid.ID, error) {
-	cp, err := p.connection()
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	cp, err := p.connectionFor(methodContainerList)
 	if err != nil {
 		return nil, err
 	}
@@ -2359,7 +3352,13 @@ func (p *Pool) ListContainers(ctx context.Context, prm PrmContainerList) ([]cid.
 //
 // Success can be verified by reading by identifier (see GetContainer).
 func (p *Pool) DeleteContainer(ctx context.Context, prm PrmContainerDelete) error {
-	cp, err := p.connection()
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	cp, err := p.connectionFor(methodContainerDelete)
 	if err != nil {
 		return err
 	}
@@ -2371,7 +3370,13 @@ func (p *Pool) DeleteContainer(ctx context.Context, prm PrmContainerDelete) erro
 //
 // Main return value MUST NOT be processed on an erroneous return.
 func (p *Pool) GetEACL(ctx context.Context, prm PrmContainerEACL) (eacl.Table, error) {
-	cp, err := p.connection()
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return eacl.Table{}, err
+	}
+	defer done()
+
+	cp, err := p.connectionFor(methodContainerEACL)
 	if err != nil {
 		return eacl.Table{}, err
 	}
@@ -2388,7 +3393,13 @@ func (p *Pool) GetEACL(ctx context.Context, prm PrmContainerEACL) (eacl.Table, e
 //
 // Success can be verified by reading by identifier (see GetEACL).
 func (p *Pool) SetEACL(ctx context.Context, prm PrmContainerSetEACL) error {
-	cp, err := p.connection()
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	cp, err := p.connectionFor(methodContainerSetEACL)
 	if err != nil {
 		return err
 	}
@@ -2400,7 +3411,13 @@ func (p *Pool) SetEACL(ctx context.Context, prm PrmContainerSetEACL) error {
 //
 // Main return value MUST NOT be processed on an erroneous return.
 func (p *Pool) Balance(ctx context.Context, prm PrmBalanceGet) (accounting.Decimal, error) {
-	cp, err := p.connection()
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return accounting.Decimal{}, err
+	}
+	defer done()
+
+	cp, err := p.connectionFor(methodBalanceGet)
 	if err != nil {
 		return accounting.Decimal{}, err
 	}
@@ -2409,16 +3426,33 @@ func (p *Pool) Balance(ctx context.Context, prm PrmBalanceGet) (accounting.Decim
 }
 
 // Statistic returns connection statistics.
-func (p Pool) Statistic() Statistic {
-	stat := Statistic{}
-	for _, inner := range p.innerPools {
+func (p *Pool) Statistic() Statistic {
+	p.membersLock.RLock()
+	defer p.membersLock.RUnlock()
+
+	stat := Statistic{
+		hedges:    p.hedges.Load(),
+		hedgeWins: p.hedgeWins.Load(),
+	}
+	for i, inner := range p.innerPools {
+		params := p.rebalanceParams.nodesParams[i]
+
 		inner.lock.RLock()
-		for _, cl := range inner.clients {
+		for j, cl := range inner.clients {
 			node := NodeStatistic{
 				address:       cl.address(),
 				methods:       cl.methodsStatus(),
 				overallErrors: cl.overallErrorRate(),
 				currentErrors: cl.currentErrorRate(),
+				healthy:       cl.isHealthy(),
+				maintenance:   cl.isMaintenance(),
+				priority:      params.priority,
+				weight:        params.weights[j],
+				latency:       cl.latency(),
+				circuit:       cl.circuitState(),
+			}
+			if j < len(inner.weights) {
+				node.effectiveWeight = inner.weights[j]
 			}
 			stat.nodes = append(stat.nodes, node)
 			stat.overallErrors += node.overallErrors
@@ -2496,7 +3530,18 @@ func waitFor(ctx context.Context, params *WaitParams, condition func(context.Con
 //
 // Main return value MUST NOT be processed on an erroneous return.
 func (p *Pool) NetworkInfo(ctx context.Context) (netmap.NetworkInfo, error) {
-	cp, err := p.connection()
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return netmap.NetworkInfo{}, err
+	}
+	defer done()
+
+	return p.networkInfo(ctx)
+}
+
+// networkInfo is NetworkInfo without the operation tracking.
+func (p *Pool) networkInfo(ctx context.Context) (netmap.NetworkInfo, error) {
+	cp, err := p.connectionFor(methodNetworkInfo)
 	if err != nil {
 		return netmap.NetworkInfo{}, err
 	}
@@ -2504,10 +3549,31 @@ func (p *Pool) NetworkInfo(ctx context.Context) (netmap.NetworkInfo, error) {
 	return cp.networkInfo(ctx, prmNetworkInfo{})
 }
 
-// Close closes the Pool and releases all the associated resources.
+// NetMapSnapshot requests information about the NeoFS network map.
+//
+// Main return value MUST NOT be processed on an erroneous return.
+func (p *Pool) NetMapSnapshot(ctx context.Context) (netmap.NetMap, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return netmap.NetMap{}, err
+	}
+	defer done()
+
+	cp, err := p.connectionFor(methodNetMapSnapshot)
+	if err != nil {
+		return netmap.NetMap{}, err
+	}
+
+	return cp.netMapSnapshot(ctx, prmNetMapSnapshot{})
+}
+
+// Close closes the Pool and releases all the associated resources. Active
+// operations are canceled, use Shutdown to wait for them.
 func (p *Pool) Close() {
-	p.cancel()
-	<-p.closedCh
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _ = p.Shutdown(ctx)
 }
 
 // SyncContainerWithNetwork applies network configuration received via
@@ -2531,6 +3597,67 @@ func SyncContainerWithNetwork(ctx context.Context, cnr *container.Container, p *
 
 // GetSplitInfo implements relations.Relations.
 func (p *Pool) GetSplitInfo(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) (*object.SplitInfo, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	return poolRelations{p}.GetSplitInfo(ctx, cnrID, objID, tokens)
+}
+
+// ListChildrenByLinker implements relations.Relations.
+func (p *Pool) ListChildrenByLinker(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) ([]oid.ID, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	return poolRelations{p}.ListChildrenByLinker(ctx, cnrID, objID, tokens)
+}
+
+// GetLeftSibling implements relations.Relations.
+func (p *Pool) GetLeftSibling(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) (oid.ID, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return oid.ID{}, err
+	}
+	defer done()
+
+	return poolRelations{p}.GetLeftSibling(ctx, cnrID, objID, tokens)
+}
+
+// FindSiblingBySplitID implements relations.Relations.
+func (p *Pool) FindSiblingBySplitID(ctx context.Context, cnrID cid.ID, splitID *object.SplitID, tokens relations.Tokens) ([]oid.ID, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	return poolRelations{p}.FindSiblingBySplitID(ctx, cnrID, splitID, tokens)
+}
+
+// FindSiblingByParentID implements relations.Relations.
+func (p *Pool) FindSiblingByParentID(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) ([]oid.ID, error) {
+	ctx, done, err := p.beginOperation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	return poolRelations{p}.FindSiblingByParentID(ctx, cnrID, objID, tokens)
+}
+
+// poolRelations implements relations.Relations through the Pool without the
+// operation tracking, so it can be used within the tracked Pool operations.
+type poolRelations struct {
+	p *Pool
+}
+
+// GetSplitInfo implements relations.Relations.
+func (x poolRelations) GetSplitInfo(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) (*object.SplitInfo, error) {
 	var addr oid.Address
 	addr.SetContainer(cnrID)
 	addr.SetObject(objID)
@@ -2545,7 +3672,7 @@ func (p *Pool) GetSplitInfo(ctx context.Context, cnrID cid.ID, objID oid.ID, tok
 	}
 	prm.MarkRaw()
 
-	_, err := p.HeadObject(ctx, prm)
+	_, err := x.p.headObject(ctx, prm)
 
 	var errSplit *object.SplitInfoError
 
@@ -2560,7 +3687,7 @@ func (p *Pool) GetSplitInfo(ctx context.Context, cnrID cid.ID, objID oid.ID, tok
 }
 
 // ListChildrenByLinker implements relations.Relations.
-func (p *Pool) ListChildrenByLinker(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) ([]oid.ID, error) {
+func (x poolRelations) ListChildrenByLinker(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) ([]oid.ID, error) {
 	var addr oid.Address
 	addr.SetContainer(cnrID)
 	addr.SetObject(objID)
@@ -2574,7 +3701,7 @@ func (p *Pool) ListChildrenByLinker(ctx context.Context, cnrID cid.ID, objID oid
 		prm.UseSession(*tokens.Session)
 	}
 
-	res, err := p.HeadObject(ctx, prm)
+	res, err := x.p.headObject(ctx, prm)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get linking object's header: %w", err)
 	}
@@ -2583,7 +3710,7 @@ func (p *Pool) ListChildrenByLinker(ctx context.Context, cnrID cid.ID, objID oid
 }
 
 // GetLeftSibling implements relations.Relations.
-func (p *Pool) GetLeftSibling(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) (oid.ID, error) {
+func (x poolRelations) GetLeftSibling(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) (oid.ID, error) {
 	var addr oid.Address
 	addr.SetContainer(cnrID)
 	addr.SetObject(objID)
@@ -2597,7 +3724,7 @@ func (p *Pool) GetLeftSibling(ctx context.Context, cnrID cid.ID, objID oid.ID, t
 		prm.UseSession(*tokens.Session)
 	}
 
-	res, err := p.HeadObject(ctx, prm)
+	res, err := x.p.headObject(ctx, prm)
 	if err != nil {
 		return oid.ID{}, fmt.Errorf("failed to read split chain member's header: %w", err)
 	}
@@ -2610,7 +3737,7 @@ func (p *Pool) GetLeftSibling(ctx context.Context, cnrID cid.ID, objID oid.ID, t
 }
 
 // FindSiblingBySplitID implements relations.Relations.
-func (p *Pool) FindSiblingBySplitID(ctx context.Context, cnrID cid.ID, splitID *object.SplitID, tokens relations.Tokens) ([]oid.ID, error) {
+func (x poolRelations) FindSiblingBySplitID(ctx context.Context, cnrID cid.ID, splitID *object.SplitID, tokens relations.Tokens) ([]oid.ID, error) {
 	var query object.SearchFilters
 	query.AddSplitIDFilter(object.MatchStringEqual, splitID)
 
@@ -2624,7 +3751,7 @@ func (p *Pool) FindSiblingBySplitID(ctx context.Context, cnrID cid.ID, splitID *
 		prm.UseSession(*tokens.Session)
 	}
 
-	res, err := p.SearchObjects(ctx, prm)
+	res, err := x.p.searchObjects(ctx, prm)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search objects by split ID: %w", err)
 	}
@@ -2642,7 +3769,7 @@ func (p *Pool) FindSiblingBySplitID(ctx context.Context, cnrID cid.ID, splitID *
 }
 
 // FindSiblingByParentID implements relations.Relations.
-func (p *Pool) FindSiblingByParentID(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) ([]oid.ID, error) {
+func (x poolRelations) FindSiblingByParentID(ctx context.Context, cnrID cid.ID, objID oid.ID, tokens relations.Tokens) ([]oid.ID, error) {
 	var query object.SearchFilters
 	query.AddParentIDFilter(object.MatchStringEqual, objID)
 
@@ -2656,7 +3783,7 @@ func (p *Pool) FindSiblingByParentID(ctx context.Context, cnrID cid.ID, objID oi
 		prm.UseSession(*tokens.Session)
 	}
 
-	resSearch, err := p.SearchObjects(ctx, prm)
+	resSearch, err := x.p.searchObjects(ctx, prm)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find object children: %w", err)
 	}
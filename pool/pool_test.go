@@ -15,6 +15,7 @@ import (
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/telemetry"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -265,6 +266,53 @@ func TestTwoFailed(t *testing.T) {
 	require.Contains(t, err.Error(), "no healthy")
 }
 
+func TestStatusPropagation(t *testing.T) {
+	const reason = "any reason"
+
+	mockClientBuilder := func(addr string) client {
+		var st apistatus.ObjectAccessDenied
+		st.WriteReason(reason)
+
+		mockCli := newMockClient(addr, *newPrivateKey(t))
+		mockCli.statusOnGetObject(st)
+		return mockCli
+	}
+
+	opts := InitParameters{
+		key: newPrivateKey(t),
+		nodeParams: []NodeParam{
+			{1, "peer0", 1},
+		},
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.setClientBuilder(mockClientBuilder)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	err = pool.Dial(ctx)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	var prm PrmObjectGet
+	prm.SetAddress(oid.Address{})
+
+	_, err = pool.GetObject(ctx, prm)
+	require.ErrorIs(t, err, apistatus.ErrObjectAccessDenied)
+	require.True(t, apistatus.IsAuth(err))
+
+	var st apistatus.ObjectAccessDenied
+	require.ErrorAs(t, err, &st)
+	require.Equal(t, reason, st.Reason())
+
+	// access denial is not a node failure
+	cp, err := pool.connection()
+	require.NoError(t, err)
+	require.Zero(t, cp.currentErrorRate())
+}
+
 func TestSessionCache(t *testing.T) {
 	key := newPrivateKey(t)
 	expectedAuthKey := neofsecdsa.PublicKey(key.PublicKey)
@@ -593,7 +641,7 @@ func TestHandleError(t *testing.T) {
 			status:        apistatus.NodeUnderMaintenance{},
 			err:           nil,
 			expectedError: true,
-			countError:    true,
+			countError:    false,
 		},
 	} {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
@@ -612,6 +660,75 @@ func TestHandleError(t *testing.T) {
 	}
 }
 
+func TestHandleErrorMaintenance(t *testing.T) {
+	monitor := newClientStatusMonitor("", 10)
+
+	err := monitor.handleError(apistatus.NodeUnderMaintenance{}, nil)
+	require.ErrorIs(t, err, apistatus.ErrNodeUnderMaintenance)
+	require.True(t, monitor.isMaintenance())
+	require.True(t, monitor.isHealthy())
+	require.Zero(t, monitor.overallErrorRate())
+	require.False(t, isAvailable(&monitor))
+}
+
+func TestMaintenance(t *testing.T) {
+	nodes := []NodeParam{
+		{1, "peer0", 1},
+		{1, "peer1", 1},
+	}
+
+	clients := make(map[string]*mockClient, len(nodes))
+	for _, node := range nodes {
+		clients[node.address] = newMockClient(node.address, *newPrivateKey(t))
+	}
+
+	clients["peer0"].setNetmapMaintenance(true)
+
+	opts := InitParameters{
+		key:                      newPrivateKey(t),
+		nodeParams:               nodes,
+		clientRebalanceInterval:  200 * time.Millisecond,
+		maintenanceProbeInterval: 50 * time.Millisecond,
+	}
+	opts.setClientBuilder(func(addr string) client {
+		return clients[addr]
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	err = pool.Dial(ctx)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	nodeMaintenance := func(addr string) bool {
+		node, err := pool.Statistic().Node(addr)
+		require.NoError(t, err)
+		return node.Maintenance()
+	}
+
+	require.Eventually(t, func() bool { return nodeMaintenance("peer0") }, 3*time.Second, 50*time.Millisecond)
+	require.False(t, nodeMaintenance("peer1"))
+
+	// node under maintenance is healthy but is drained without errors
+	node, err := pool.Statistic().Node("peer0")
+	require.NoError(t, err)
+	require.True(t, node.Healthy())
+	require.Zero(t, node.OverallErrors())
+
+	for i := 0; i < 20; i++ {
+		cp, err := pool.connection()
+		require.NoError(t, err)
+		require.Equal(t, "peer1", cp.address())
+	}
+
+	clients["peer0"].setNetmapMaintenance(false)
+
+	require.Eventually(t, func() bool { return !nodeMaintenance("peer0") }, time.Second, 10*time.Millisecond)
+}
+
 func TestSwitchAfterErrorThreshold(t *testing.T) {
 	nodes := []NodeParam{
 		{1, "peer0", 1},
@@ -665,3 +782,58 @@ func TestSwitchAfterErrorThreshold(t *testing.T) {
 	_, err = conn.objectGet(ctx, PrmObjectGet{})
 	require.NoError(t, err)
 }
+
+func TestExporter(t *testing.T) {
+	mocks := make(map[string]*mockClient)
+
+	mockClientBuilder := func(addr string) client {
+		mockCli := newMockClient(addr, *newPrivateKey(t))
+		mocks[addr] = mockCli
+		return mockCli
+	}
+
+	exp := telemetry.NewCollector()
+
+	opts := InitParameters{
+		key: newPrivateKey(t),
+		nodeParams: []NodeParam{
+			{1, "peer0", 1},
+			{1, "peer1", 1},
+		},
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.setClientBuilder(mockClientBuilder)
+	opts.SetExporter(exp)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	err = pool.Dial(ctx)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	mocks["peer1"].errOnEndpointInfo()
+	pool.updateInnerNodesHealth(ctx, 0, make([]float64, 2))
+
+	health := exp.Health()
+	require.Len(t, health, 1)
+	require.Equal(t, "peer1", health[0].Endpoint())
+	require.False(t, health[0].Healthy())
+
+	var prm PrmObjectPut
+	prm.SetHeader(object.Object{})
+
+	_, err = pool.PutObject(ctx, prm)
+	require.NoError(t, err)
+
+	pool.cache.DeleteByPrefix("peer0")
+
+	_, err = pool.PutObject(ctx, prm)
+	require.NoError(t, err)
+
+	hits, misses := exp.SessionCache()
+	require.EqualValues(t, 1, hits)
+	require.EqualValues(t, 1, misses)
+}
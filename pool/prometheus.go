@@ -0,0 +1,218 @@
+package pool
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// prometheusContentType is a content type of the Prometheus text exposition format.
+const prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// WritePrometheus writes the statistic to w in the Prometheus text exposition
+// format. Node metrics are labelled by the node address, request metrics are
+// labelled by the node address and the method name (see MethodIndex.String).
+// Availability of the priority groups is labelled by the group priority.
+func (s Statistic) WritePrometheus(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	pw := promWriter{w: bw}
+
+	pw.header("neofs_pool_errors_total", "counter", "Number of errors on all pool connections.")
+	pw.sample("neofs_pool_errors_total", nil, float64(s.overallErrors))
+
+	pw.header("neofs_pool_hedges_total", "counter", "Number of the hedged reads.")
+	pw.sample("neofs_pool_hedges_total", nil, float64(s.hedges))
+
+	pw.header("neofs_pool_hedge_wins_total", "counter", "Number of the hedged reads completed before the original ones.")
+	pw.sample("neofs_pool_hedge_wins_total", nil, float64(s.hedgeWins))
+
+	pw.header("neofs_pool_node_errors_total", "counter", "Number of errors on the node connection.")
+	for i := range s.nodes {
+		pw.sample("neofs_pool_node_errors_total", nodeLabels(s.nodes[i]), float64(s.nodes[i].overallErrors))
+	}
+
+	pw.header("neofs_pool_node_current_errors", "gauge", "Number of errors on the node connection since the last health restoration.")
+	for i := range s.nodes {
+		pw.sample("neofs_pool_node_current_errors", nodeLabels(s.nodes[i]), float64(s.nodes[i].currentErrors))
+	}
+
+	pw.header("neofs_pool_node_healthy", "gauge", "Health status of the node connection.")
+	for i := range s.nodes {
+		pw.sample("neofs_pool_node_healthy", nodeLabels(s.nodes[i]), boolValue(s.nodes[i].healthy))
+	}
+
+	pw.header("neofs_pool_node_maintenance", "gauge", "Maintenance status of the node.")
+	for i := range s.nodes {
+		pw.sample("neofs_pool_node_maintenance", nodeLabels(s.nodes[i]), boolValue(s.nodes[i].maintenance))
+	}
+
+	pw.header("neofs_pool_node_circuit_state", "gauge", "State of the node circuit breaker: 0 - closed, 1 - open, 2 - half-open.")
+	for i := range s.nodes {
+		pw.sample("neofs_pool_node_circuit_state", nodeLabels(s.nodes[i]), float64(s.nodes[i].circuit))
+	}
+
+	pw.header("neofs_pool_node_weight", "gauge", "Weight of the node within its priority group.")
+	for i := range s.nodes {
+		pw.sample("neofs_pool_node_weight", append(nodeLabels(s.nodes[i]), "priority", strconv.Itoa(s.nodes[i].priority)), s.nodes[i].weight)
+	}
+
+	pw.header("neofs_pool_node_effective_weight", "gauge", "Weight of the node currently used for the request balancing within its priority group.")
+	for i := range s.nodes {
+		pw.sample("neofs_pool_node_effective_weight", append(nodeLabels(s.nodes[i]), "priority", strconv.Itoa(s.nodes[i].priority)), s.nodes[i].effectiveWeight)
+	}
+
+	pw.header("neofs_pool_node_latency_seconds", "gauge", "Moving average of the request latency to the node.")
+	for i := range s.nodes {
+		pw.sample("neofs_pool_node_latency_seconds", nodeLabels(s.nodes[i]), s.nodes[i].latency.Seconds())
+	}
+
+	pw.header("neofs_pool_node_request_duration_seconds", "summary", "Duration of the requests to the node.")
+	for i := range s.nodes {
+		for m := range s.nodes[i].methods {
+			labels := append(nodeLabels(s.nodes[i]), "method", MethodIndex(m).String())
+			st := s.nodes[i].methods[m]
+
+			pw.sample("neofs_pool_node_request_duration_seconds_sum", labels, time.Duration(st.allTime).Seconds())
+			pw.sample("neofs_pool_node_request_duration_seconds_count", labels, float64(st.allRequests))
+		}
+	}
+
+	groups := s.priorityGroups()
+
+	pw.header("neofs_pool_group_nodes", "gauge", "Number of nodes in the priority group.")
+	for i := range groups {
+		pw.sample("neofs_pool_group_nodes", groupLabels(groups[i].priority), float64(groups[i].nodes))
+	}
+
+	pw.header("neofs_pool_group_healthy_nodes", "gauge", "Number of healthy nodes in the priority group.")
+	for i := range groups {
+		pw.sample("neofs_pool_group_healthy_nodes", groupLabels(groups[i].priority), float64(groups[i].healthy))
+	}
+
+	pw.header("neofs_pool_group_available", "gauge", "Availability of the priority group: at least one node is healthy and not under maintenance.")
+	for i := range groups {
+		pw.sample("neofs_pool_group_available", groupLabels(groups[i].priority), boolValue(groups[i].available > 0))
+	}
+
+	if pw.err != nil {
+		return pw.err
+	}
+
+	return bw.Flush()
+}
+
+// NewStatisticHandler returns http.Handler which responds with the current
+// Pool statistic in the Prometheus text exposition format.
+//
+// See also Statistic.WritePrometheus.
+func NewStatisticHandler(p *Pool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", prometheusContentType)
+		_ = p.Statistic().WritePrometheus(w)
+	})
+}
+
+// groupStatistic is an aggregated statistic of the priority group.
+type groupStatistic struct {
+	priority int
+
+	nodes, healthy, available int
+}
+
+// priorityGroups returns statistic of the priority groups sorted by priority.
+func (s Statistic) priorityGroups() []groupStatistic {
+	var res []groupStatistic
+
+	for i := range s.nodes {
+		j := sort.Search(len(res), func(j int) bool { return res[j].priority >= s.nodes[i].priority })
+		if j == len(res) || res[j].priority != s.nodes[i].priority {
+			res = append(res, groupStatistic{})
+			copy(res[j+1:], res[j:])
+			res[j] = groupStatistic{priority: s.nodes[i].priority}
+		}
+
+		res[j].nodes++
+
+		if s.nodes[i].healthy {
+			res[j].healthy++
+
+			if !s.nodes[i].maintenance {
+				res[j].available++
+			}
+		}
+	}
+
+	return res
+}
+
+func nodeLabels(n NodeStatistic) []string {
+	return []string{"node", n.address}
+}
+
+func groupLabels(priority int) []string {
+	return []string{"priority", strconv.Itoa(priority)}
+}
+
+func boolValue(v bool) float64 {
+	if v {
+		return 1
+	}
+
+	return 0
+}
+
+// promWriter writes metrics in the Prometheus text exposition format
+// remembering the first error.
+type promWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+// header writes HELP and TYPE lines of the metric family.
+func (x *promWriter) header(name, typ, help string) {
+	if x.err == nil {
+		_, x.err = fmt.Fprintf(x.w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+	}
+}
+
+// sample writes metric sample with labels in key-value pair format.
+func (x *promWriter) sample(name string, labels []string, value float64) {
+	if x.err != nil {
+		return
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(name)
+
+	if len(labels) > 0 {
+		sb.WriteByte('{')
+
+		for i := 0; i < len(labels); i += 2 {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+
+			sb.WriteString(labels[i])
+			sb.WriteString(`="`)
+			sb.WriteString(labelValueReplacer.Replace(labels[i+1]))
+			sb.WriteByte('"')
+		}
+
+		sb.WriteByte('}')
+	}
+
+	sb.WriteByte(' ')
+	sb.WriteString(strconv.FormatFloat(value, 'g', -1, 64))
+	sb.WriteByte('\n')
+
+	_, x.err = x.w.WriteString(sb.String())
+}
+
+// labelValueReplacer escapes label values according to the format.
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
@@ -0,0 +1,126 @@
+package pool
+
+import (
+	"bytes"
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatistic_WritePrometheus(t *testing.T) {
+	methods := make([]statusSnapshot, methodLast)
+	methods[methodObjectGet] = statusSnapshot{
+		allTime:     uint64(3 * time.Second),
+		allRequests: 2,
+	}
+
+	s := Statistic{
+		overallErrors: 5,
+		hedges:        3,
+		hedgeWins:     2,
+		nodes: []NodeStatistic{
+			{
+				address:         "peer0",
+				methods:         methods,
+				overallErrors:   5,
+				currentErrors:   1,
+				priority:        1,
+				weight:          1,
+				effectiveWeight: 1,
+				latency:         1500 * time.Millisecond,
+			},
+			{
+				address:  `peer"1`,
+				methods:  make([]statusSnapshot, methodLast),
+				healthy:  true,
+				priority: 2,
+				weight:   0.25,
+			},
+			{
+				address:  "peer2",
+				methods:  make([]statusSnapshot, methodLast),
+				priority: 2,
+				weight:   0.75,
+			},
+			{
+				address:     "peer3",
+				methods:     make([]statusSnapshot, methodLast),
+				healthy:     true,
+				maintenance: true,
+				priority:    3,
+				weight:      1,
+				circuit:     CircuitOpen,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, s.WritePrometheus(&buf))
+
+	out := buf.String()
+
+	for _, line := range []string{
+		"# TYPE neofs_pool_errors_total counter",
+		"neofs_pool_errors_total 5",
+		"neofs_pool_hedges_total 3",
+		"neofs_pool_hedge_wins_total 2",
+		`neofs_pool_node_errors_total{node="peer0"} 5`,
+		`neofs_pool_node_current_errors{node="peer0"} 1`,
+		`neofs_pool_node_healthy{node="peer0"} 0`,
+		`neofs_pool_node_healthy{node="peer\"1"} 1`,
+		`neofs_pool_node_weight{node="peer2",priority="2"} 0.75`,
+		`neofs_pool_node_request_duration_seconds_sum{node="peer0",method="objectGet"} 3`,
+		`neofs_pool_node_request_duration_seconds_count{node="peer0",method="objectGet"} 2`,
+		`neofs_pool_group_nodes{priority="2"} 2`,
+		`neofs_pool_group_healthy_nodes{priority="2"} 1`,
+		`neofs_pool_group_available{priority="1"} 0`,
+		`neofs_pool_group_available{priority="2"} 1`,
+		`neofs_pool_node_maintenance{node="peer3"} 1`,
+		`neofs_pool_node_effective_weight{node="peer0",priority="1"} 1`,
+		`neofs_pool_node_latency_seconds{node="peer0"} 1.5`,
+		`neofs_pool_node_maintenance{node="peer0"} 0`,
+		`neofs_pool_group_healthy_nodes{priority="3"} 1`,
+		`neofs_pool_group_available{priority="3"} 0`,
+		`neofs_pool_node_circuit_state{node="peer3"} 1`,
+		`neofs_pool_node_circuit_state{node="peer0"} 0`,
+	} {
+		require.Contains(t, out, line+"\n")
+	}
+}
+
+func TestNewStatisticHandler(t *testing.T) {
+	mockClientBuilder := func(addr string) client {
+		return newMockClient(addr, *newPrivateKey(t))
+	}
+
+	opts := InitParameters{
+		key: newPrivateKey(t),
+		nodeParams: []NodeParam{
+			{1, "peer0", 1},
+			{1, "peer1", 3},
+		},
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.setClientBuilder(mockClientBuilder)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	err = pool.Dial(ctx)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	rec := httptest.NewRecorder()
+	NewStatisticHandler(pool).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	require.Equal(t, prometheusContentType, rec.Header().Get("Content-Type"))
+
+	body := rec.Body.String()
+	require.Contains(t, body, `neofs_pool_node_weight{node="peer1",priority="1"} 0.75`+"\n")
+	require.Contains(t, body, `neofs_pool_group_available{priority="1"} 1`+"\n")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -119,3 +120,129 @@ func TestHealthyNoReweight(t *testing.T) {
 	defer inner.lock.RUnlock()
 	require.Equal(t, inner.sampler, sampl)
 }
+
+func TestAdaptiveWeights(t *testing.T) {
+	var (
+		weights = []float64{0.25, 0.25, 0.25, 0.25}
+		names   = []string{"node0", "node1", "node2", "node3"}
+		buffer  = make([]float64, len(weights))
+		clients = make([]*mockClient, len(names))
+	)
+
+	for i := range names {
+		clients[i] = newMockClient(names[i], *newPrivateKey(t))
+	}
+
+	clients[0].observeLatency(10 * time.Millisecond)
+	clients[1].observeLatency(40 * time.Millisecond)
+	for i := 0; i < 5; i++ {
+		clients[1].incErrorRate()
+	}
+	// node2 has no requests yet
+	clients[3].observeLatency(time.Millisecond)
+	clients[3].errOnDial()
+
+	inner := &innerPool{
+		sampler: newSampler(weights, rand.NewSource(0)),
+		clients: []client{clients[0], clients[1], clients[2], clients[3]},
+	}
+
+	cache, err := newCache()
+	require.NoError(t, err)
+
+	p := &Pool{
+		innerPools: []*innerPool{inner},
+		cache:      cache,
+		rebalanceParams: rebalanceParameters{
+			nodesParams:     []*nodesParam{{addresses: names, weights: weights}},
+			errorThreshold:  10,
+			adaptiveWeights: true,
+		},
+	}
+
+	p.updateInnerNodesHealth(context.TODO(), 0, buffer)
+
+	// 0.25, 0.25 * (10/40) * (1 - 5/10), 0.25, 0
+	expected := []float64{0.25 / 0.53125, 0.03125 / 0.53125, 0.25 / 0.53125, 0}
+
+	stat := p.Statistic()
+	for i := range names {
+		node, err := stat.Node(names[i])
+		require.NoError(t, err)
+		require.InDelta(t, expected[i], node.EffectiveWeight(), 1e-9, names[i])
+		require.Equal(t, weights[i], node.Weight())
+	}
+}
+
+func TestLatencyEWMA(t *testing.T) {
+	monitor := newClientStatusMonitor("", 10)
+	require.Zero(t, monitor.latency())
+
+	monitor.observeLatency(100 * time.Millisecond)
+	require.Equal(t, 100*time.Millisecond, monitor.latency())
+
+	monitor.observeLatency(200 * time.Millisecond)
+	require.Equal(t, 120*time.Millisecond, monitor.latency())
+
+	// measured latency is not reseeded
+	monitor.seedLatency(time.Second)
+	require.Equal(t, 120*time.Millisecond, monitor.latency())
+
+	t.Run("seed", func(t *testing.T) {
+		monitor := newClientStatusMonitor("", 10)
+
+		monitor.seedLatency(time.Second)
+		require.Equal(t, time.Second, monitor.latency())
+
+		// the first request replaces seeded value
+		monitor.observeLatency(100 * time.Millisecond)
+		require.Equal(t, 100*time.Millisecond, monitor.latency())
+	})
+
+	t.Run("streams", func(t *testing.T) {
+		var prm wrapperPrm
+		prm.setAddress("node")
+
+		wrapper := newWrapper(prm)
+
+		// payload parts are counted, but do not affect the latency
+		wrapper.incStreamRequests(time.Second, methodObjectPut)
+		require.Zero(t, wrapper.latency())
+		require.EqualValues(t, 1, wrapper.methodsStatus()[methodObjectPut].allRequests)
+
+		wrapper.incRequests(100*time.Millisecond, methodObjectPut)
+		require.Equal(t, 100*time.Millisecond, wrapper.latency())
+
+		wrapper.incStreamRequests(time.Second, methodObjectPut)
+		require.Equal(t, 100*time.Millisecond, wrapper.latency())
+		require.EqualValues(t, 3, wrapper.methodsStatus()[methodObjectPut].allRequests)
+	})
+}
+
+func TestPowerOfTwoChoices(t *testing.T) {
+	const count = 1000
+
+	fast := newMockClient("fast", *newPrivateKey(t))
+	fast.observeLatency(time.Millisecond)
+
+	slow := newMockClient("slow", *newPrivateKey(t))
+	slow.observeLatency(time.Second)
+
+	inner := &innerPool{
+		sampler:    newSampler([]float64{0.5, 0.5}, rand.NewSource(0)),
+		clients:    []client{slow, fast},
+		powerOfTwo: true,
+	}
+
+	var fastCount int
+	for i := 0; i < count; i++ {
+		cp, err := inner.connection()
+		require.NoError(t, err)
+		if cp.address() == "fast" {
+			fastCount++
+		}
+	}
+
+	// slow node is selected only if it is sampled twice
+	require.InDelta(t, 0.75*count, fastCount, 0.05*count)
+}
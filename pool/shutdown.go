@@ -0,0 +1,236 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+)
+
+// ErrPoolClosed is returned by the Pool operations started after the Pool
+// shutdown.
+var ErrPoolClosed = errors.New("pool is closed")
+
+// ResShutdown groups resulting values of Pool.Shutdown.
+type ResShutdown struct {
+	aborted int
+}
+
+// Aborted returns number of the operations which were not completed before the
+// shutdown context was done and were canceled. Pool membership changes (see
+// AddNode) are not counted: they are denied after the shutdown, but the ones
+// in progress are not awaited and canceled.
+func (x ResShutdown) Aborted() int {
+	return x.aborted
+}
+
+// operations tracks active Pool operations. Zero value is ready to use.
+type operations struct {
+	mu     sync.Mutex
+	closed bool
+	nextID uint64
+	active map[uint64]context.CancelFunc
+	// idle is closed when the last active operation of the closed set completes
+	idle chan struct{}
+	// drains are waiting for the particular active operations to complete
+	drains []drainWaiter
+}
+
+// drainWaiter waits for the pending operations to complete.
+type drainWaiter struct {
+	pending map[uint64]struct{}
+	done    chan struct{}
+}
+
+// begin registers new operation. Returned context is canceled on abort or
+// when the operation completes. Returns ErrPoolClosed if the set is closed.
+func (x *operations) begin(ctx context.Context) (context.Context, func(), error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if x.closed {
+		return nil, nil, ErrPoolClosed
+	}
+
+	if x.active == nil {
+		x.active = make(map[uint64]context.CancelFunc)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	id := x.nextID
+	x.nextID++
+	x.active[id] = cancel
+
+	var once sync.Once
+
+	return ctx, func() {
+		once.Do(func() {
+			cancel()
+			x.end(id)
+		})
+	}, nil
+}
+
+func (x *operations) end(id uint64) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	delete(x.active, id)
+
+	drains := x.drains[:0]
+	for _, w := range x.drains {
+		delete(w.pending, id)
+		if len(w.pending) == 0 {
+			close(w.done)
+		} else {
+			drains = append(drains, w)
+		}
+	}
+	x.drains = drains
+
+	if x.closed && len(x.active) == 0 && x.idle != nil {
+		close(x.idle)
+		x.idle = nil
+	}
+}
+
+// close denies new operations and returns channel which is closed when all
+// active operations complete. Returns false if the set is already closed.
+func (x *operations) close() (<-chan struct{}, bool) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if x.closed {
+		return nil, false
+	}
+
+	x.closed = true
+
+	idle := make(chan struct{})
+	if len(x.active) == 0 {
+		close(idle)
+	} else {
+		x.idle = idle
+	}
+
+	return idle, true
+}
+
+// isClosed checks if the set is closed.
+func (x *operations) isClosed() bool {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	return x.closed
+}
+
+// drain returns channel which is closed when all currently active operations
+// complete or are aborted. Operations started after the call are not awaited.
+func (x *operations) drain() <-chan struct{} {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	done := make(chan struct{})
+	if len(x.active) == 0 {
+		close(done)
+		return done
+	}
+
+	pending := make(map[uint64]struct{}, len(x.active))
+	for id := range x.active {
+		pending[id] = struct{}{}
+	}
+
+	x.drains = append(x.drains, drainWaiter{pending: pending, done: done})
+
+	return done
+}
+
+// abort cancels all active operations and returns their number.
+func (x *operations) abort() int {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	for _, cancel := range x.active {
+		cancel()
+	}
+
+	for _, w := range x.drains {
+		close(w.done)
+	}
+	x.drains = nil
+
+	return len(x.active)
+}
+
+// beginOperation registers new Pool operation. Returned function must be
+// called when the operation completes, it's safe to call it several times.
+func (p *Pool) beginOperation(ctx context.Context) (context.Context, func(), error) {
+	return p.ops.begin(ctx)
+}
+
+// Shutdown gracefully shuts down the Pool: it stops accepting new operations
+// (they fail with ErrPoolClosed), waits for the active operations to complete
+// and closes connections to the nodes. Operation is considered active until
+// the stream it opens is closed (see ResGetObject.Payload, ResObjectRange and
+// ResObjectSearch). If ctx is done before all the operations are completed,
+// the remaining ones are canceled and ctx error is returned.
+//
+// Number of the canceled operations is reported in the result. Returns
+// ErrPoolClosed if the Pool is already closed.
+func (p *Pool) Shutdown(ctx context.Context) (ResShutdown, error) {
+	idle, ok := p.ops.close()
+	if !ok {
+		return ResShutdown{}, ErrPoolClosed
+	}
+
+	var (
+		res ResShutdown
+		err error
+	)
+
+	// ctx may be already done, e.g. in Close, so idle Pool is checked first
+	select {
+	case <-idle:
+	default:
+		select {
+		case <-idle:
+		case <-ctx.Done():
+			// operations could complete concurrently
+			if res.aborted = p.ops.abort(); res.aborted > 0 {
+				err = ctx.Err()
+			}
+		}
+	}
+
+	if p.cancel != nil {
+		p.cancel()
+		<-p.closedCh
+	}
+
+	p.membersLock.RLock()
+	innerPools := p.innerPools
+	p.membersLock.RUnlock()
+
+	for _, inner := range innerPools {
+		inner.lock.RLock()
+		for _, cl := range inner.clients {
+			_ = cl.close()
+		}
+		inner.lock.RUnlock()
+	}
+
+	return res, err
+}
+
+// operationReadCloser completes the Pool operation on close.
+type operationReadCloser struct {
+	io.ReadCloser
+	done func()
+}
+
+// Close implements io.Closer.
+func (x *operationReadCloser) Close() error {
+	defer x.done()
+	return x.ReadCloser.Close()
+}
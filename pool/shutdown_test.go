@@ -0,0 +1,185 @@
+package pool
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func newShutdownTestPool(t *testing.T, mock *mockClient) *Pool {
+	opts := InitParameters{
+		key:                     newPrivateKey(t),
+		nodeParams:              []NodeParam{{1, mock.address(), 1}},
+		clientRebalanceInterval: 30 * time.Second,
+	}
+	opts.setClientBuilder(func(string) client {
+		return mock
+	})
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, pool.Dial(context.Background()))
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+func TestShutdown(t *testing.T) {
+	mock := newMockClient("peer0", *newPrivateKey(t))
+	mock.getPayload = []byte("payload")
+
+	pool := newShutdownTestPool(t, mock)
+
+	var prm PrmObjectGet
+	prm.SetAddress(oid.Address{})
+
+	res, err := pool.GetObject(context.Background(), prm)
+	require.NoError(t, err)
+
+	payload := make(chan []byte, 1)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+
+		data, _ := io.ReadAll(res.Payload)
+		_ = res.Payload.Close()
+		payload <- data
+	}()
+
+	resShutdown, err := pool.Shutdown(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, mock.getPayload, <-payload)
+	require.Zero(t, resShutdown.Aborted())
+	require.True(t, mock.closed.Load())
+
+	_, err = pool.GetObject(context.Background(), prm)
+	require.ErrorIs(t, err, ErrPoolClosed)
+
+	_, err = pool.Balance(context.Background(), PrmBalanceGet{})
+	require.ErrorIs(t, err, ErrPoolClosed)
+
+	_, err = pool.Shutdown(context.Background())
+	require.ErrorIs(t, err, ErrPoolClosed)
+}
+
+func TestShutdownAbort(t *testing.T) {
+	mock := newMockClient("peer0", *newPrivateKey(t))
+	mock.getPayload = []byte("payload")
+	mock.headDelay = time.Minute
+
+	pool := newShutdownTestPool(t, mock)
+
+	var prmGet PrmObjectGet
+	prmGet.SetAddress(oid.Address{})
+
+	// payload is never closed
+	_, err := pool.GetObject(context.Background(), prmGet)
+	require.NoError(t, err)
+
+	var prmHead PrmObjectHead
+	prmHead.SetAddress(oid.Address{})
+
+	headErr := make(chan error, 1)
+	go func() {
+		_, err := pool.HeadObject(context.Background(), prmHead)
+		headErr <- err
+	}()
+
+	require.Eventually(t, func() bool {
+		pool.ops.mu.Lock()
+		defer pool.ops.mu.Unlock()
+		return len(pool.ops.active) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	res, err := pool.Shutdown(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 2, res.Aborted())
+	require.True(t, mock.closed.Load())
+
+	require.ErrorIs(t, <-headErr, context.Canceled)
+	require.True(t, mock.headCanceled.Load())
+}
+
+func TestShutdownNestedCalls(t *testing.T) {
+	mock := newMockClient("peer0", *newPrivateKey(t))
+	mock.headDelay = 100 * time.Millisecond
+	mock.headSplitInfo = object.NewSplitInfo()
+	mock.headSplitInfo.SetLink(oidtest.ID())
+
+	pool := newShutdownTestPool(t, mock)
+
+	var prm PrmObjectDelete
+	prm.SetAddress(oid.Address{})
+
+	deleteErr := make(chan error, 1)
+	go func() {
+		// linking object is read after the shutdown
+		deleteErr <- pool.DeleteObject(context.Background(), prm)
+	}()
+
+	require.Eventually(t, func() bool {
+		pool.ops.mu.Lock()
+		defer pool.ops.mu.Unlock()
+		return len(pool.ops.active) > 0
+	}, time.Second, 10*time.Millisecond)
+
+	res, err := pool.Shutdown(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, res.Aborted())
+	require.NoError(t, <-deleteErr)
+}
+
+func TestShutdownMultiStepOperation(t *testing.T) {
+	mock := newMockClient("peer0", *newPrivateKey(t))
+	mock.netInfoDelay = 100 * time.Millisecond
+	mock.netInfo.SetCurrentEpoch(10)
+	mock.netInfo.SetEpochDuration(100)
+	mock.netInfo.SetMsPerBlock(1000)
+
+	pool := newShutdownTestPool(t, mock)
+
+	var prm PrmObjectLock
+	prm.SetMembers(oidtest.ID())
+	prm.SetExpirationTime(time.Now().Add(time.Hour))
+
+	lockErr := make(chan error, 1)
+	go func() {
+		// LOCK object is stored after the shutdown
+		_, err := pool.LockObjects(context.Background(), prm)
+		lockErr <- err
+	}()
+
+	require.Eventually(t, func() bool {
+		pool.ops.mu.Lock()
+		defer pool.ops.mu.Unlock()
+		return len(pool.ops.active) > 0
+	}, time.Second, 10*time.Millisecond)
+
+	res, err := pool.Shutdown(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, res.Aborted())
+	require.NoError(t, <-lockErr)
+	require.Len(t, mock.putHeaders, 1)
+}
+
+func TestShutdownIdle(t *testing.T) {
+	mock := newMockClient("peer0", *newPrivateKey(t))
+
+	pool := newShutdownTestPool(t, mock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res, err := pool.Shutdown(ctx)
+	require.NoError(t, err)
+	require.Zero(t, res.Aborted())
+	require.True(t, mock.closed.Load())
+}
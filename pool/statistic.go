@@ -8,6 +8,8 @@ import (
 // Statistic is metrics of the pool.
 type Statistic struct {
 	overallErrors uint64
+	hedges        uint64
+	hedgeWins     uint64
 	nodes         []NodeStatistic
 }
 
@@ -16,6 +18,18 @@ func (s Statistic) OverallErrors() uint64 {
 	return s.overallErrors
 }
 
+// Hedges returns number of the hedged reads, i.e. extra requests sent because
+// the original read was too slow (see InitParameters.SetHedging).
+func (s Statistic) Hedges() uint64 {
+	return s.hedges
+}
+
+// HedgeWins returns number of the hedged reads which completed before the
+// original ones.
+func (s Statistic) HedgeWins() uint64 {
+	return s.hedgeWins
+}
+
 // Nodes returns list of nodes statistic.
 func (s Statistic) Nodes() []NodeStatistic {
 	return s.nodes
@@ -38,10 +52,64 @@ func (s Statistic) Node(address string) (*NodeStatistic, error) {
 
 // NodeStatistic is metrics of certain connections.
 type NodeStatistic struct {
-	address       string
-	methods       []statusSnapshot
-	overallErrors uint64
-	currentErrors uint32
+	address         string
+	methods         []statusSnapshot
+	overallErrors   uint64
+	currentErrors   uint32
+	healthy         bool
+	maintenance     bool
+	priority        int
+	weight          float64
+	effectiveWeight float64
+	latency         time.Duration
+	circuit         CircuitState
+}
+
+// Healthy returns health status of the node connection.
+func (n NodeStatistic) Healthy() bool {
+	return n.healthy
+}
+
+// Maintenance checks if the node is under maintenance. Such nodes don't
+// receive requests until the pool re-probes them and finds the maintenance
+// over (see InitParameters.SetMaintenanceProbeInterval).
+func (n NodeStatistic) Maintenance() bool {
+	return n.maintenance
+}
+
+// Priority returns priority of the node group (see NodeParam.SetPriority).
+func (n NodeStatistic) Priority() int {
+	return n.priority
+}
+
+// Weight returns weight of the node normalized within its priority group
+// (see NodeParam.SetWeight).
+func (n NodeStatistic) Weight() float64 {
+	return n.weight
+}
+
+// EffectiveWeight returns normalized weight of the node which is currently used
+// for the request balancing within its priority group. It is zero for unhealthy
+// nodes and nodes under maintenance and, if adaptive weights are enabled, it
+// reflects the node latency and error rate (see
+// InitParameters.EnableAdaptiveWeights).
+func (n NodeStatistic) EffectiveWeight() float64 {
+	return n.effectiveWeight
+}
+
+// Latency returns exponentially weighted moving average of the request latency
+// to the node. Transfer of the object payload is not taken into account. Nodes
+// added to the dialed Pool start with the average latency of their priority
+// group.
+func (n NodeStatistic) Latency() time.Duration {
+	return n.latency
+}
+
+// CircuitState returns state of the node circuit breaker. If circuit breakers
+// are maintained per method, the most restrictive state of the method circuit
+// breakers is returned (see InitParameters.SetCircuitBreaker).
+func (n NodeStatistic) CircuitState() CircuitState {
+	return n.circuit
 }
 
 // OverallErrors returns all errors on current node.
@@ -114,6 +182,11 @@ func (n NodeStatistic) AverageNetworkInfo() time.Duration {
 	return n.averageTime(methodNetworkInfo)
 }
 
+// AverageNetMapSnapshot returns average time to perform NetMapSnapshot request.
+func (n NodeStatistic) AverageNetMapSnapshot() time.Duration {
+	return n.averageTime(methodNetMapSnapshot)
+}
+
 // AveragePutObject returns average time to perform ObjectPut request.
 func (n NodeStatistic) AveragePutObject() time.Duration {
 	return n.averageTime(methodObjectPut)
@@ -139,6 +212,11 @@ func (n NodeStatistic) AverageRangeObject() time.Duration {
 	return n.averageTime(methodObjectRange)
 }
 
+// AverageHashObject returns average time to perform ObjectHash request.
+func (n NodeStatistic) AverageHashObject() time.Duration {
+	return n.averageTime(methodObjectHash)
+}
+
 // AverageCreateSession returns average time to perform SessionCreate request.
 func (n NodeStatistic) AverageCreateSession() time.Duration {
 	return n.averageTime(methodSessionCreate)
@@ -0,0 +1,231 @@
+package telemetry
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// CallStat is an aggregated statistic of the NeoFS API calls of a particular
+// method to a particular endpoint.
+type CallStat struct {
+	endpoint, method string
+
+	calls, errors uint64
+
+	sent, received uint64
+
+	latency Histogram
+}
+
+// Endpoint returns network address of the remote server.
+func (x CallStat) Endpoint() string {
+	return x.endpoint
+}
+
+// Method returns full name of the NeoFS API method.
+func (x CallStat) Method() string {
+	return x.method
+}
+
+// Calls returns number of calls.
+func (x CallStat) Calls() uint64 {
+	return x.calls
+}
+
+// Errors returns number of failed calls.
+func (x CallStat) Errors() uint64 {
+	return x.errors
+}
+
+// BytesSent returns total size of the messages sent by the client.
+func (x CallStat) BytesSent() uint64 {
+	return x.sent
+}
+
+// BytesReceived returns total size of the messages received by the client.
+func (x CallStat) BytesReceived() uint64 {
+	return x.received
+}
+
+// Latency returns distribution of the call durations.
+func (x CallStat) Latency() Histogram {
+	return x.latency
+}
+
+// HealthStat is a statistic of the connection health to a particular endpoint.
+type HealthStat struct {
+	endpoint string
+
+	healthy bool
+
+	transitions uint64
+
+	changed time.Time
+}
+
+// Endpoint returns network address of the remote server.
+func (x HealthStat) Endpoint() string {
+	return x.endpoint
+}
+
+// Healthy returns the last observed health status.
+func (x HealthStat) Healthy() bool {
+	return x.healthy
+}
+
+// Transitions returns number of observed health status changes.
+func (x HealthStat) Transitions() uint64 {
+	return x.transitions
+}
+
+// Changed returns time of the last health status change.
+func (x HealthStat) Changed() time.Time {
+	return x.changed
+}
+
+type callKey struct {
+	endpoint, method string
+}
+
+// Collector is an in-memory Exporter which aggregates all the received
+// telemetry. Collector is safe for concurrent use.
+//
+// Collector must be created using NewCollector.
+type Collector struct {
+	buckets []time.Duration
+
+	mtx sync.Mutex
+
+	calls map[callKey]*CallStat
+
+	health map[string]*HealthStat
+
+	hits, misses uint64
+}
+
+// NewCollector constructs Collector with the given upper bounds of the latency
+// histograms. DefaultBuckets are used if no bounds are specified.
+func NewCollector(buckets ...time.Duration) *Collector {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+
+	return &Collector{
+		buckets: buckets,
+		calls:   make(map[callKey]*CallStat),
+		health:  make(map[string]*HealthStat),
+	}
+}
+
+// ExportSpan implements Exporter by aggregating the span into the statistic
+// of the corresponding endpoint and method.
+func (x *Collector) ExportSpan(s Span) {
+	key := callKey{
+		endpoint: s.Endpoint,
+		method:   s.Method,
+	}
+
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	st, ok := x.calls[key]
+	if !ok {
+		st = &CallStat{
+			endpoint: s.Endpoint,
+			method:   s.Method,
+			latency:  NewHistogram(x.buckets...),
+		}
+
+		x.calls[key] = st
+	}
+
+	st.calls++
+
+	if s.Err != nil {
+		st.errors++
+	}
+
+	st.sent += uint64(s.BytesSent)
+	st.received += uint64(s.BytesReceived)
+	st.latency.Observe(s.Duration)
+}
+
+// ObserveHealth implements Exporter by tracking health status transitions.
+func (x *Collector) ObserveHealth(endpoint string, healthy bool) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	st, ok := x.health[endpoint]
+	if !ok {
+		st = &HealthStat{endpoint: endpoint}
+		x.health[endpoint] = st
+	}
+
+	st.healthy = healthy
+	st.transitions++
+	st.changed = time.Now()
+}
+
+// ObserveSessionCache implements Exporter by counting cache hits and misses.
+func (x *Collector) ObserveSessionCache(_ string, hit bool) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if hit {
+		x.hits++
+	} else {
+		x.misses++
+	}
+}
+
+// Calls returns statistic of all observed calls sorted by endpoint and method.
+func (x *Collector) Calls() []CallStat {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	res := make([]CallStat, 0, len(x.calls))
+
+	for _, st := range x.calls {
+		cp := *st
+		cp.latency = st.latency.copy()
+
+		res = append(res, cp)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].endpoint != res[j].endpoint {
+			return res[i].endpoint < res[j].endpoint
+		}
+
+		return res[i].method < res[j].method
+	})
+
+	return res
+}
+
+// Health returns health statistic of all observed endpoints sorted by address.
+func (x *Collector) Health() []HealthStat {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	res := make([]HealthStat, 0, len(x.health))
+
+	for _, st := range x.health {
+		res = append(res, *st)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].endpoint < res[j].endpoint
+	})
+
+	return res
+}
+
+// SessionCache returns numbers of session cache hits and misses.
+func (x *Collector) SessionCache() (hits, misses uint64) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	return x.hits, x.misses
+}
@@ -0,0 +1,85 @@
+package telemetry_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/telemetry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogram(t *testing.T) {
+	h := telemetry.NewHistogram(time.Second, 10*time.Millisecond, time.Second)
+	require.Equal(t, []time.Duration{10 * time.Millisecond, time.Second}, h.Bounds())
+
+	h.Observe(time.Millisecond)
+	h.Observe(10 * time.Millisecond)
+	h.Observe(500 * time.Millisecond)
+	h.Observe(time.Minute)
+
+	require.Equal(t, []uint64{2, 3, 4}, h.Buckets())
+	require.EqualValues(t, 4, h.Count())
+	require.Equal(t, time.Minute+511*time.Millisecond, h.Sum())
+
+	var empty telemetry.Histogram
+	empty.Observe(time.Hour)
+	require.Equal(t, []uint64{1}, empty.Buckets())
+}
+
+func TestCollector(t *testing.T) {
+	c := telemetry.NewCollector(time.Second)
+
+	c.ExportSpan(telemetry.Span{
+		Method:        "b",
+		Endpoint:      "node1",
+		Duration:      time.Millisecond,
+		BytesSent:     10,
+		BytesReceived: 20,
+	})
+	c.ExportSpan(telemetry.Span{
+		Method:        "b",
+		Endpoint:      "node1",
+		Duration:      time.Minute,
+		BytesSent:     1,
+		BytesReceived: 2,
+		Err:           errors.New("any error"),
+	})
+	c.ExportSpan(telemetry.Span{
+		Method:   "a",
+		Endpoint: "node1",
+	})
+
+	calls := c.Calls()
+	require.Len(t, calls, 2)
+	require.Equal(t, "a", calls[0].Method())
+	require.Equal(t, "node1", calls[1].Endpoint())
+	require.EqualValues(t, 2, calls[1].Calls())
+	require.EqualValues(t, 1, calls[1].Errors())
+	require.EqualValues(t, 11, calls[1].BytesSent())
+	require.EqualValues(t, 22, calls[1].BytesReceived())
+	require.Equal(t, []uint64{1, 2}, calls[1].Latency().Buckets())
+
+	// snapshot is not affected by further observations
+	c.ExportSpan(telemetry.Span{Method: "b", Endpoint: "node1"})
+	require.EqualValues(t, 2, calls[1].Latency().Count())
+
+	c.ObserveHealth("node2", false)
+	c.ObserveHealth("node1", false)
+	c.ObserveHealth("node1", true)
+
+	health := c.Health()
+	require.Len(t, health, 2)
+	require.Equal(t, "node1", health[0].Endpoint())
+	require.True(t, health[0].Healthy())
+	require.EqualValues(t, 2, health[0].Transitions())
+	require.False(t, health[1].Healthy())
+
+	c.ObserveSessionCache("node1", true)
+	c.ObserveSessionCache("node1", false)
+	c.ObserveSessionCache("node2", true)
+
+	hits, misses := c.SessionCache()
+	require.EqualValues(t, 2, hits)
+	require.EqualValues(t, 1, misses)
+}
@@ -0,0 +1,23 @@
+/*
+Package telemetry provides instrumentation of the NeoFS API communication.
+
+Exporter is an interface of the telemetry consumer. client.Client emits a Span
+per NeoFS API call, pool.Pool additionally reports node health transitions and
+session cache usage. Exporter may be implemented on top of any monitoring
+system (e.g. Prometheus or OpenTelemetry) without making the SDK depend on it.
+
+Collector is a ready-to-use in-memory Exporter which aggregates call latencies
+into histograms:
+
+	c := telemetry.NewCollector()
+
+	var prm pool.InitParameters
+	prm.SetExporter(c)
+	// ...
+
+	for _, st := range c.Calls() {
+		h := st.Latency()
+		// ...
+	}
+*/
+package telemetry
@@ -0,0 +1,114 @@
+package telemetry
+
+import (
+	"sort"
+	"time"
+)
+
+// DefaultBuckets are default upper bounds of the Collector latency histograms.
+var DefaultBuckets = []time.Duration{
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// Histogram is a distribution of durations over the buckets with fixed upper
+// bounds.
+//
+// Instances can be created using built-in var declaration, but in this case
+// all values fall into the single implicit +Inf bucket. Use NewHistogram to
+// set bounds.
+type Histogram struct {
+	bounds []time.Duration
+
+	// len(bounds)+1 items, the last one is +Inf bucket
+	counts []uint64
+
+	sum time.Duration
+}
+
+// NewHistogram constructs Histogram with the given bucket upper bounds.
+// Bounds are sorted and deduplicated.
+func NewHistogram(bounds ...time.Duration) Histogram {
+	bs := make([]time.Duration, len(bounds))
+	copy(bs, bounds)
+
+	sort.Slice(bs, func(i, j int) bool { return bs[i] < bs[j] })
+
+	for i := 1; i < len(bs); i++ {
+		if bs[i] == bs[i-1] {
+			bs = append(bs[:i], bs[i+1:]...)
+			i--
+		}
+	}
+
+	return Histogram{
+		bounds: bs,
+		counts: make([]uint64, len(bs)+1),
+	}
+}
+
+// Observe adds the value to the corresponding bucket.
+func (x *Histogram) Observe(d time.Duration) {
+	if x.counts == nil {
+		x.counts = make([]uint64, len(x.bounds)+1)
+	}
+
+	x.counts[sort.Search(len(x.bounds), func(i int) bool { return d <= x.bounds[i] })]++
+	x.sum += d
+}
+
+// Bounds returns upper bounds of the buckets except +Inf.
+//
+// Result must not be mutated.
+func (x Histogram) Bounds() []time.Duration {
+	return x.bounds
+}
+
+// Buckets returns cumulative counters of the values less than or equal to the
+// corresponding bound (see Bounds). The last item is a counter of all values.
+func (x Histogram) Buckets() []uint64 {
+	res := make([]uint64, len(x.bounds)+1)
+
+	var sum uint64
+
+	for i := range x.counts {
+		sum += x.counts[i]
+		res[i] = sum
+	}
+
+	return res
+}
+
+// Count returns number of observed values.
+func (x Histogram) Count() uint64 {
+	var res uint64
+
+	for i := range x.counts {
+		res += x.counts[i]
+	}
+
+	return res
+}
+
+// Sum returns sum of observed values.
+func (x Histogram) Sum() time.Duration {
+	return x.sum
+}
+
+// copy returns deep copy of the Histogram.
+func (x Histogram) copy() Histogram {
+	res := x
+	res.counts = make([]uint64, len(x.counts))
+	copy(res.counts, x.counts)
+
+	return res
+}
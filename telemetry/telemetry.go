@@ -0,0 +1,50 @@
+package telemetry
+
+import (
+	"time"
+)
+
+// Span describes single NeoFS API call. For message streams, span covers the
+// whole stream lifetime.
+type Span struct {
+	// Full name of the NeoFS API method (see client.Method* constants).
+	Method string
+
+	// Network address of the remote server.
+	Endpoint string
+
+	// Code of the last received response status. Zero if the call failed
+	// before any response was processed.
+	StatusCode uint32
+
+	// Time when the call was started.
+	Start time.Time
+
+	// Duration of the call.
+	Duration time.Duration
+
+	// Numbers of messages sent and received by the client.
+	MessagesSent, MessagesReceived int
+
+	// Sizes of the encoded messages sent and received by the client.
+	BytesSent, BytesReceived int
+
+	// Call failure. NeoFS API failure statuses are reflected here only if the
+	// client resolves them into errors.
+	Err error
+}
+
+// Exporter is an interface of the telemetry consumer. Methods are called
+// synchronously, so they should not block for a long time. Methods may be
+// called concurrently.
+type Exporter interface {
+	// ExportSpan handles span of the completed NeoFS API call.
+	ExportSpan(Span)
+
+	// ObserveHealth handles health status change of the connection to the
+	// given endpoint.
+	ObserveHealth(endpoint string, healthy bool)
+
+	// ObserveSessionCache handles session cache lookup for the given endpoint.
+	ObserveSessionCache(endpoint string, hit bool)
+}